@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/gmhafiz/go8/config"
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/domain/audit"
+	db "github.com/gmhafiz/go8/third_party/database"
+)
+
+const usage = `Usage: audit verify [-public-key <base64>]
+
+Walks the audit log hash chain and reports the first broken link. Checkpoint
+signatures are checked with -public-key, or else with the public key of
+AUDIT_SIGNING_KEY when it is set.`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "verify" {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	publicKey := flags.String("public-key", "", "base64 ed25519 public key verifying checkpoints")
+	_ = flags.Parse(os.Args[2:])
+
+	cfg := config.New()
+
+	pub, err := verifyingKey(*publicKey, cfg.Audit.SigningKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if pub == nil {
+		log.Println("no public key given, checkpoint signatures are not checked")
+	}
+
+	store := db.NewSqlx(cfg.Database)
+	client := gen.NewClient(gen.Driver(entsql.OpenDB(dialect.Postgres, store.DB)))
+	defer client.Close()
+
+	result, err := audit.Verify(context.Background(), client, pub)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if result.Break != nil {
+		fmt.Printf("audit log is broken after %d intact entries: %s\n", result.Entries, result.Break)
+		os.Exit(1)
+	}
+	fmt.Printf("audit log is intact: %d entries and %d checkpoints verified.\n", result.Entries, result.Checkpoints)
+}
+
+func verifyingKey(publicKey, signingKey string) (ed25519.PublicKey, error) {
+	if publicKey != "" {
+		b, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil || len(b) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("public key must be %d base64 encoded bytes", ed25519.PublicKeySize)
+		}
+		return b, nil
+	}
+
+	if signingKey == "" {
+		return nil, nil
+	}
+	key, err := audit.ParseSigningKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.Public().(ed25519.PublicKey), nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/andybalholm/brotli"
+)
+
+const usage = `Usage: precompress <dir>
+
+Writes a .br and a .gz copy, compressed as much as possible, next to each text
+file in dir that is worth compressing, to be served by middleware.Precompressed.`
+
+// minSize is the smallest file worth compressing, the default of
+// middleware.Compress.
+const minSize = 1024
+
+var extensions = []string{".css", ".html", ".js", ".json", ".yaml"}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(os.Args[1], "*"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, name := range matches {
+		if !slices.Contains(extensions, filepath.Ext(name)) {
+			continue
+		}
+		stat, err := os.Stat(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if stat.Size() < minSize {
+			continue
+		}
+
+		err = compress(name, name+".br", func(w io.Writer) io.WriteCloser {
+			return brotli.NewWriterLevel(w, brotli.BestCompression)
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = compress(name, name+".gz", func(w io.Writer) io.WriteCloser {
+			enc, _ := gzip.NewWriterLevel(w, gzip.BestCompression)
+			return enc
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(name)
+	}
+}
+
+func compress(src, dst string, newWriter func(io.Writer) io.WriteCloser) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	enc := newWriter(out)
+	if _, err := io.Copy(enc, in); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
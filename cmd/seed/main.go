@@ -5,13 +5,14 @@ import (
 	"github.com/gmhafiz/go8/config"
 	"github.com/gmhafiz/go8/database"
 	db "github.com/gmhafiz/go8/third_party/database"
+	"github.com/gmhafiz/go8/third_party/password"
 )
 
 func main() {
 	cfg := config.New()
 	store := db.NewSqlx(cfg.Database)
 
-	seeder := database.Seeder(store.DB)
+	seeder := database.Seeder(store.DB, password.New(cfg.Password))
 	seeder.SeedUsers()
 	fmt.Println("seeding completed.")
 }
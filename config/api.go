@@ -10,8 +10,26 @@ type Api struct {
 	Name              string        `default:"go8_api"`
 	Host              string        `default:"0.0.0.0"`
 	Port              string        `default:"3080"`
+	PublicURL         string        `split_words:"true" default:"http://localhost:3080"`
 	ReadHeaderTimeout time.Duration `split_words:"true" default:"60s"`
-	GracefulTimeout   time.Duration `split_words:"true" default:"8s"`
+	// ReadTimeout is how long a client may take to send a whole request,
+	// body included.
+	ReadTimeout time.Duration `split_words:"true" default:"30s"`
+	// WriteTimeout is how long a response may take, from the end of reading
+	// the request header. It must be longer than any RequestTimeout, or
+	// responses of slow requests are cut off instead of answered with 503.
+	WriteTimeout time.Duration `split_words:"true" default:"90s"`
+	// IdleTimeout is how long a keep-alive connection may wait for its next
+	// request.
+	IdleTimeout time.Duration `split_words:"true" default:"120s"`
+	// RequestTimeout is how long a handler may take before its context is
+	// cancelled and it is answered with 503. Some routes have their own.
+	RequestTimeout  time.Duration `split_words:"true" default:"15s"`
+	GracefulTimeout time.Duration `split_words:"true" default:"8s"`
+	// TrustedProxies are the addresses and CIDR ranges of reverse proxies
+	// whose X-Forwarded-For and X-Real-Ip headers are believed. Requests from
+	// anywhere else are known by the address of their connection.
+	TrustedProxies []string `split_words:"true"`
 
 	RequestLog bool `split_words:"true" default:"false"`
 	RunSwagger bool `split_words:"true" default:"true"`
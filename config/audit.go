@@ -0,0 +1,22 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Audit configures signed checkpoints of the audit log hash chain.
+type Audit struct {
+	// SigningKey is a base64 ed25519 seed, for example from
+	// `openssl rand -base64 32`. Checkpoints are not signed if it is empty.
+	SigningKey         string        `split_words:"true"`
+	CheckpointInterval time.Duration `split_words:"true" default:"1h"`
+}
+
+func NewAudit() Audit {
+	var audit Audit
+	envconfig.MustProcess("AUDIT", &audit)
+
+	return audit
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Compress configures compression of response bodies.
+type Compress struct {
+	Enable bool `default:"true"`
+	// MinSize is the smallest body in bytes worth compressing.
+	MinSize int `split_words:"true" default:"1024"`
+	// ContentTypes are the media types to compress, such as text/* or
+	// application/*+json. Empty means middleware.DefaultCompressibleTypes.
+	ContentTypes []string `split_words:"true"`
+}
+
+func NewCompress() Compress {
+	var compress Compress
+	envconfig.MustProcess("COMPRESS", &compress)
+
+	return compress
+}
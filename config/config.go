@@ -16,6 +16,15 @@ type Config struct {
 
 	OpenTelemetry
 	Session
+	Oidc
+	MagicLink
+	Registration
+	Scim
+	Mail
+	Password
+	Audit
+	RateLimit
+	Compress
 }
 
 func New() *Config {
@@ -32,5 +41,14 @@ func New() *Config {
 		Elasticsearch: ElasticSearch(),
 		Session:       NewSession(),
 		OpenTelemetry: NewOpenTelemetry(),
+		Oidc:          NewOidc(),
+		MagicLink:     NewMagicLink(),
+		Registration:  NewRegistration(),
+		Scim:          NewScim(),
+		Mail:          NewMail(),
+		Password:      NewPassword(),
+		Audit:         NewAudit(),
+		RateLimit:     NewRateLimit(),
+		Compress:      NewCompress(),
 	}
 }
@@ -8,14 +8,31 @@ import (
 )
 
 type Session struct {
-	Name     string          `split_words:"true" default:"session"`
-	Path     string          `default:"/"`
-	Domain   string          `default:""`
-	Secret   string          `required:"false"`
-	Duration time.Duration   `default:"24h"`
-	HttpOnly bool            `split_words:"true" default:"true"`
-	Secure   bool            `default:"true"`
-	SameSite SameSiteDecoder `split_words:"true" default:"lax"`
+	Name   string `split_words:"true" default:"session"`
+	Path   string `default:"/"`
+	Domain string `default:""`
+	// Secret is a comma separated list of keys used to hash session and csrf
+	// tokens. The first one hashes new tokens, the rest are still accepted so
+	// that secrets can be rotated.
+	Secret []string `required:"false"`
+	// Duration is how long a session lasts after logging in, however active
+	// it is. Its cookie is deleted when the browser is closed.
+	Duration time.Duration `default:"24h"`
+	// IdleTimeout logs out a session not used for this long. Zero disables it.
+	// Remembered sessions are exempt.
+	IdleTimeout time.Duration `split_words:"true" default:"2h"`
+	// RememberMeDuration replaces Duration when a user logs in with
+	// remember_me. Its cookie survives closing the browser.
+	RememberMeDuration time.Duration `split_words:"true" default:"720h"`
+	// MaxPerUser logs out the oldest sessions of a user beyond this many. Zero
+	// means no limit.
+	MaxPerUser int             `split_words:"true" default:"0"`
+	HttpOnly   bool            `split_words:"true" default:"true"`
+	Secure     bool            `default:"true"`
+	SameSite   SameSiteDecoder `split_words:"true" default:"lax"`
+	// Store is where sessions are saved, either `postgres` or `redis`. Redis
+	// connection is configured with REDIS_* keys.
+	Store string `default:"postgres"`
 }
 
 func NewSession() Session {
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// MagicLink configures passwordless login through a link sent by email.
+type MagicLink struct {
+	Enable   bool          `default:"false"`
+	Lifetime time.Duration `default:"15m"`
+	// PostLoginRedirect is where the browser is sent after a successful login.
+	// If empty, the link responds with 200 OK instead.
+	PostLoginRedirect string `split_words:"true"`
+}
+
+func NewMagicLink() MagicLink {
+	var magicLink MagicLink
+	envconfig.MustProcess("MAGIC_LINK", &magicLink)
+
+	return magicLink
+}
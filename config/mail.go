@@ -0,0 +1,22 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Mail struct {
+	// Driver is either `log` which only prints emails to stdout, or `smtp`.
+	Driver string `default:"log"`
+	Host   string `default:"localhost"`
+	Port   string `default:"25"`
+	User   string
+	Pass   string
+	From   string `default:"no-reply@localhost"`
+}
+
+func NewMail() Mail {
+	var mail Mail
+	envconfig.MustProcess("MAIL", &mail)
+
+	return mail
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Oidc struct {
+	Enable       bool `default:"false"`
+	Issuer       string
+	ClientID     string   `split_words:"true"`
+	ClientSecret string   `split_words:"true"`
+	RedirectURL  string   `split_words:"true"`
+	Scopes       []string `default:"openid,email,profile"`
+
+	// AllowSignup provisions a new local user just in time when no existing
+	// user is linked to the external subject.
+	AllowSignup bool `split_words:"true" default:"false"`
+	// PostLoginRedirect is where the browser is sent after a successful login.
+	// If empty, the callback responds with 200 OK instead.
+	PostLoginRedirect string `split_words:"true"`
+}
+
+func NewOidc() Oidc {
+	var oidc Oidc
+	envconfig.MustProcess("OIDC", &oidc)
+
+	return oidc
+}
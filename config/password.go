@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Password holds argon2id parameters used to hash new passwords. Existing
+// hashes made with different parameters are upgraded on next login.
+//
+// Hashing is limited to Workers at a time, with at most QueueSize more waiting.
+// Peak memory used for hashing is roughly Workers * Memory.
+type Password struct {
+	// Memory is in KiB.
+	Memory      uint32 `default:"65536"`
+	Iterations  uint32 `default:"1"`
+	Parallelism uint8  `default:"2"`
+	SaltLength  uint32 `split_words:"true" default:"16"`
+	KeyLength   uint32 `split_words:"true" default:"32"`
+	// Workers defaults to number of CPUs when set to 0.
+	Workers   int `default:"0"`
+	QueueSize int `split_words:"true" default:"32"`
+}
+
+func NewPassword() Password {
+	var password Password
+	envconfig.MustProcess("PASSWORD", &password)
+
+	return password
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// RateLimit configures how many requests a client may make. Each limit is
+// available at once, and is available in full again after a quiet period.
+type RateLimit struct {
+	Enable bool `default:"true"`
+	// Store is where counts are kept, either "memory" for a single instance
+	// or "redis" to share them between instances.
+	Store string `default:"memory"`
+	// Api applies to every route, counted by bearer token, then logged-in
+	// user, then client address. A limit or period of 0 disables a policy.
+	ApiLimit  int           `split_words:"true" default:"300"`
+	ApiPeriod time.Duration `split_words:"true" default:"1m"`
+	// Login applies to logging in, counted by client address.
+	LoginLimit  int           `split_words:"true" default:"10"`
+	LoginPeriod time.Duration `split_words:"true" default:"1m"`
+}
+
+func NewRateLimit() RateLimit {
+	var rateLimit RateLimit
+	envconfig.MustProcess("RATE_LIMIT", &rateLimit)
+
+	return rateLimit
+}
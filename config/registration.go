@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Registration struct {
+	// InviteOnly requires a valid invitation code to register.
+	InviteOnly bool `split_words:"true" default:"false"`
+}
+
+func NewRegistration() Registration {
+	var registration Registration
+	envconfig.MustProcess("REGISTRATION", &registration)
+
+	return registration
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Scim struct {
+	Enable bool `default:"false"`
+	// Tokens are accepted as `Authorization: Bearer <token>`. More than one
+	// can be set, comma-separated, to rotate them without downtime.
+	Tokens []string
+}
+
+func NewScim() Scim {
+	var scim Scim
+	envconfig.MustProcess("SCIM", &scim)
+
+	return scim
+}
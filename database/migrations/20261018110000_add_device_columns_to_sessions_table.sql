@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sessions
+    -- token is a hash and must never be shown. public_id identifies a session to its user instead.
+    ADD COLUMN public_id    UUID        NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    ADD COLUMN created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN ip_address   TEXT,
+    ADD COLUMN user_agent   TEXT,
+    ADD COLUMN device       TEXT;
+
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS sessions_user_id_idx;
+
+ALTER TABLE sessions
+    DROP COLUMN public_id,
+    DROP COLUMN created_at,
+    DROP COLUMN last_seen_at,
+    DROP COLUMN ip_address,
+    DROP COLUMN user_agent,
+    DROP COLUMN device;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN roles       JSONB NOT NULL DEFAULT '[]',
+    -- A disabled user cannot log in and their existing sessions and tokens are rejected.
+    ADD COLUMN disabled_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN roles,
+    DROP COLUMN disabled_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS magic_links
+(
+    id         bigint generated always as identity primary key,
+    user_id    BIGINT      NOT NULL CONSTRAINT magic_link_user_fk REFERENCES users ON DELETE CASCADE,
+    token_hash TEXT        NOT NULL UNIQUE,
+    nonce_hash TEXT        NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS magic_links_user_id_idx ON magic_links (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE magic_links;
+-- +goose StatementEnd
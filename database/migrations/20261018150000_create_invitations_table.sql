@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS invitations
+(
+    id         bigint generated always as identity primary key,
+    code_hash  TEXT        NOT NULL UNIQUE,
+    created_by BIGINT CONSTRAINT invitation_user_fk REFERENCES users ON DELETE SET NULL,
+    roles      JSONB       NOT NULL DEFAULT '[]',
+    max_uses   INT         NOT NULL DEFAULT 1 CHECK (max_uses > 0),
+    uses       INT         NOT NULL DEFAULT 0 CHECK (uses <= max_uses),
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS invitations_created_by_idx ON invitations (created_by);
+
+CREATE TABLE IF NOT EXISTS invitation_uses
+(
+    id            bigint generated always as identity primary key,
+    invitation_id BIGINT      NOT NULL CONSTRAINT invitation_use_invitation_fk REFERENCES invitations ON DELETE CASCADE,
+    user_id       BIGINT      NOT NULL CONSTRAINT invitation_use_user_fk REFERENCES users ON DELETE CASCADE,
+    used_at       TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS invitation_uses_invitation_id_idx ON invitation_uses (invitation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE invitation_uses;
+DROP TABLE invitations;
+-- +goose StatementEnd
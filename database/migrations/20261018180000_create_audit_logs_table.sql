@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id           bigint generated always as identity primary key,
+    actor_id     BIGINT,
+    table_name   TEXT        NOT NULL,
+    table_row_id BIGINT      NOT NULL,
+    action       TEXT        NOT NULL,
+    old_values   JSONB,
+    new_values   JSONB,
+    http_method  TEXT        NOT NULL DEFAULT '',
+    url          TEXT        NOT NULL DEFAULT '',
+    ip_address   TEXT        NOT NULL DEFAULT '',
+    user_agent   TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS audit_logs_table_name_table_row_id_idx ON audit_logs (table_name, table_row_id);
+CREATE INDEX IF NOT EXISTS audit_logs_actor_id_idx ON audit_logs (actor_id);
+CREATE INDEX IF NOT EXISTS audit_logs_created_at_idx ON audit_logs (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_logs;
+-- +goose StatementEnd
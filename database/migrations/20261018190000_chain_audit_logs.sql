@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS prev_hash TEXT;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS hash TEXT;
+
+CREATE TABLE IF NOT EXISTS audit_checkpoints
+(
+    id           bigint generated always as identity primary key,
+    audit_log_id BIGINT      NOT NULL,
+    hash         TEXT        NOT NULL,
+    signature    TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_checkpoints;
+
+ALTER TABLE audit_logs DROP COLUMN hash;
+ALTER TABLE audit_logs DROP COLUMN prev_hash;
+-- +goose StatementEnd
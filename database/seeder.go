@@ -4,21 +4,24 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
-	"github.com/alexedwards/argon2id"
+	"github.com/gmhafiz/go8/third_party/password"
 )
 
 type Seed struct {
-	DB *sql.DB
+	DB        *sql.DB
+	Passwords *password.Hasher
 }
 
-func Seeder(db *sql.DB) *Seed {
+func Seeder(db *sql.DB, passwords *password.Hasher) *Seed {
 	return &Seed{
-		DB: db,
+		DB:        db,
+		Passwords: passwords,
 	}
 }
 
@@ -27,6 +30,7 @@ type user struct {
 	LastName  string
 	Email     string
 	Password  string
+	Roles     []string
 }
 
 func (m *Seed) SeedUsers() {
@@ -36,23 +40,29 @@ func (m *Seed) SeedUsers() {
 			LastName:  "Last Name",
 			Email:     "admin@gmhafiz.com",
 			Password:  randomAndWrite(16),
+			Roles:     []string{"admin"},
 		},
 	}
 
 	for _, u := range users {
-		password, err := argon2id.CreateHash(u.Password, argon2id.DefaultParams)
+		password, err := m.Passwords.Hash(context.Background(), u.Password)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		roles, err := json.Marshal(append([]string{}, u.Roles...))
 		if err != nil {
 			log.Fatalln(err)
 		}
 		_, err = m.DB.ExecContext(
 			context.Background(),
-			`INSERT INTO users (first_name, last_name, email, password, verified_at) 
-				VALUES ($1, $2, $3, $4, $5);`,
+			`INSERT INTO users (first_name, last_name, email, password, verified_at, roles) 
+				VALUES ($1, $2, $3, $4, $5, $6);`,
 			u.FirstName,
 			u.LastName,
 			u.Email,
 			password,
 			time.Now(),
+			roles,
 		)
 		if err != nil {
 			log.Fatalln(err)
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+)
+
+// AuditCheckpoint is the model entity for the AuditCheckpoint schema.
+type AuditCheckpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// AuditLogID holds the value of the "audit_log_id" field.
+	AuditLogID uint64 `json:"audit_log_id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Signature holds the value of the "signature" field.
+	Signature string `json:"signature,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditCheckpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditcheckpoint.FieldID, auditcheckpoint.FieldAuditLogID:
+			values[i] = new(sql.NullInt64)
+		case auditcheckpoint.FieldHash, auditcheckpoint.FieldSignature:
+			values[i] = new(sql.NullString)
+		case auditcheckpoint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditCheckpoint fields.
+func (ac *AuditCheckpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditcheckpoint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = uint64(value.Int64)
+		case auditcheckpoint.FieldAuditLogID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field audit_log_id", values[i])
+			} else if value.Valid {
+				ac.AuditLogID = uint64(value.Int64)
+			}
+		case auditcheckpoint.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				ac.Hash = value.String
+			}
+		case auditcheckpoint.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				ac.Signature = value.String
+			}
+		case auditcheckpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ac.CreatedAt = value.Time
+			}
+		default:
+			ac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditCheckpoint.
+// This includes values selected through modifiers, order, etc.
+func (ac *AuditCheckpoint) Value(name string) (ent.Value, error) {
+	return ac.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditCheckpoint.
+// Note that you need to call AuditCheckpoint.Unwrap() before calling this method if this AuditCheckpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AuditCheckpoint) Update() *AuditCheckpointUpdateOne {
+	return NewAuditCheckpointClient(ac.config).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AuditCheckpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AuditCheckpoint) Unwrap() *AuditCheckpoint {
+	_tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: AuditCheckpoint is not a transactional entity")
+	}
+	ac.config.driver = _tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AuditCheckpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditCheckpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ac.ID))
+	builder.WriteString("audit_log_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.AuditLogID))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(ac.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(ac.Signature)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ac.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditCheckpoints is a parsable slice of AuditCheckpoint.
+type AuditCheckpoints []*AuditCheckpoint
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditcheckpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLTE(FieldID, id))
+}
+
+// AuditLogID applies equality check predicate on the "audit_log_id" field. It's identical to AuditLogIDEQ.
+func AuditLogID(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldAuditLogID, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldHash, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldSignature, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AuditLogIDEQ applies the EQ predicate on the "audit_log_id" field.
+func AuditLogIDEQ(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldAuditLogID, v))
+}
+
+// AuditLogIDNEQ applies the NEQ predicate on the "audit_log_id" field.
+func AuditLogIDNEQ(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNEQ(FieldAuditLogID, v))
+}
+
+// AuditLogIDIn applies the In predicate on the "audit_log_id" field.
+func AuditLogIDIn(vs ...uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldIn(FieldAuditLogID, vs...))
+}
+
+// AuditLogIDNotIn applies the NotIn predicate on the "audit_log_id" field.
+func AuditLogIDNotIn(vs ...uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNotIn(FieldAuditLogID, vs...))
+}
+
+// AuditLogIDGT applies the GT predicate on the "audit_log_id" field.
+func AuditLogIDGT(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGT(FieldAuditLogID, v))
+}
+
+// AuditLogIDGTE applies the GTE predicate on the "audit_log_id" field.
+func AuditLogIDGTE(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGTE(FieldAuditLogID, v))
+}
+
+// AuditLogIDLT applies the LT predicate on the "audit_log_id" field.
+func AuditLogIDLT(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLT(FieldAuditLogID, v))
+}
+
+// AuditLogIDLTE applies the LTE predicate on the "audit_log_id" field.
+func AuditLogIDLTE(v uint64) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLTE(FieldAuditLogID, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldContainsFold(FieldHash, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditCheckpoint) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditCheckpoint) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditCheckpoint) predicate.AuditCheckpoint {
+	return predicate.AuditCheckpoint(sql.NotPredicates(p))
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+)
+
+// AuditCheckpointCreate is the builder for creating a AuditCheckpoint entity.
+type AuditCheckpointCreate struct {
+	config
+	mutation *AuditCheckpointMutation
+	hooks    []Hook
+}
+
+// SetAuditLogID sets the "audit_log_id" field.
+func (acc *AuditCheckpointCreate) SetAuditLogID(u uint64) *AuditCheckpointCreate {
+	acc.mutation.SetAuditLogID(u)
+	return acc
+}
+
+// SetHash sets the "hash" field.
+func (acc *AuditCheckpointCreate) SetHash(s string) *AuditCheckpointCreate {
+	acc.mutation.SetHash(s)
+	return acc
+}
+
+// SetSignature sets the "signature" field.
+func (acc *AuditCheckpointCreate) SetSignature(s string) *AuditCheckpointCreate {
+	acc.mutation.SetSignature(s)
+	return acc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acc *AuditCheckpointCreate) SetCreatedAt(t time.Time) *AuditCheckpointCreate {
+	acc.mutation.SetCreatedAt(t)
+	return acc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acc *AuditCheckpointCreate) SetNillableCreatedAt(t *time.Time) *AuditCheckpointCreate {
+	if t != nil {
+		acc.SetCreatedAt(*t)
+	}
+	return acc
+}
+
+// SetID sets the "id" field.
+func (acc *AuditCheckpointCreate) SetID(u uint64) *AuditCheckpointCreate {
+	acc.mutation.SetID(u)
+	return acc
+}
+
+// Mutation returns the AuditCheckpointMutation object of the builder.
+func (acc *AuditCheckpointCreate) Mutation() *AuditCheckpointMutation {
+	return acc.mutation
+}
+
+// Save creates the AuditCheckpoint in the database.
+func (acc *AuditCheckpointCreate) Save(ctx context.Context) (*AuditCheckpoint, error) {
+	acc.defaults()
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AuditCheckpointCreate) SaveX(ctx context.Context) *AuditCheckpoint {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *AuditCheckpointCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *AuditCheckpointCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acc *AuditCheckpointCreate) defaults() {
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		v := auditcheckpoint.DefaultCreatedAt()
+		acc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AuditCheckpointCreate) check() error {
+	if _, ok := acc.mutation.AuditLogID(); !ok {
+		return &ValidationError{Name: "audit_log_id", err: errors.New(`gen: missing required field "AuditCheckpoint.audit_log_id"`)}
+	}
+	if _, ok := acc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`gen: missing required field "AuditCheckpoint.hash"`)}
+	}
+	if _, ok := acc.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`gen: missing required field "AuditCheckpoint.signature"`)}
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "AuditCheckpoint.created_at"`)}
+	}
+	return nil
+}
+
+func (acc *AuditCheckpointCreate) sqlSave(ctx context.Context) (*AuditCheckpoint, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *AuditCheckpointCreate) createSpec() (*AuditCheckpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditCheckpoint{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(auditcheckpoint.Table, sqlgraph.NewFieldSpec(auditcheckpoint.FieldID, field.TypeUint64))
+	)
+	if id, ok := acc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := acc.mutation.AuditLogID(); ok {
+		_spec.SetField(auditcheckpoint.FieldAuditLogID, field.TypeUint64, value)
+		_node.AuditLogID = value
+	}
+	if value, ok := acc.mutation.Hash(); ok {
+		_spec.SetField(auditcheckpoint.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := acc.mutation.Signature(); ok {
+		_spec.SetField(auditcheckpoint.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	if value, ok := acc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditcheckpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditCheckpointCreateBulk is the builder for creating many AuditCheckpoint entities in bulk.
+type AuditCheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*AuditCheckpointCreate
+}
+
+// Save creates the AuditCheckpoint entities in the database.
+func (accb *AuditCheckpointCreateBulk) Save(ctx context.Context) ([]*AuditCheckpoint, error) {
+	if accb.err != nil {
+		return nil, accb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AuditCheckpoint, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditCheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AuditCheckpointCreateBulk) SaveX(ctx context.Context) []*AuditCheckpoint {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *AuditCheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *AuditCheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
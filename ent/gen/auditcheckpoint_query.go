@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// AuditCheckpointQuery is the builder for querying AuditCheckpoint entities.
+type AuditCheckpointQuery struct {
+	config
+	ctx        *QueryContext
+	order      []auditcheckpoint.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AuditCheckpoint
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AuditCheckpointQuery builder.
+func (acq *AuditCheckpointQuery) Where(ps ...predicate.AuditCheckpoint) *AuditCheckpointQuery {
+	acq.predicates = append(acq.predicates, ps...)
+	return acq
+}
+
+// Limit the number of records to be returned by this query.
+func (acq *AuditCheckpointQuery) Limit(limit int) *AuditCheckpointQuery {
+	acq.ctx.Limit = &limit
+	return acq
+}
+
+// Offset to start from.
+func (acq *AuditCheckpointQuery) Offset(offset int) *AuditCheckpointQuery {
+	acq.ctx.Offset = &offset
+	return acq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (acq *AuditCheckpointQuery) Unique(unique bool) *AuditCheckpointQuery {
+	acq.ctx.Unique = &unique
+	return acq
+}
+
+// Order specifies how the records should be ordered.
+func (acq *AuditCheckpointQuery) Order(o ...auditcheckpoint.OrderOption) *AuditCheckpointQuery {
+	acq.order = append(acq.order, o...)
+	return acq
+}
+
+// First returns the first AuditCheckpoint entity from the query.
+// Returns a *NotFoundError when no AuditCheckpoint was found.
+func (acq *AuditCheckpointQuery) First(ctx context.Context) (*AuditCheckpoint, error) {
+	nodes, err := acq.Limit(1).All(setContextOp(ctx, acq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{auditcheckpoint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) FirstX(ctx context.Context) *AuditCheckpoint {
+	node, err := acq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AuditCheckpoint ID from the query.
+// Returns a *NotFoundError when no AuditCheckpoint ID was found.
+func (acq *AuditCheckpointQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = acq.Limit(1).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{auditcheckpoint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := acq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AuditCheckpoint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AuditCheckpoint entity is found.
+// Returns a *NotFoundError when no AuditCheckpoint entities are found.
+func (acq *AuditCheckpointQuery) Only(ctx context.Context) (*AuditCheckpoint, error) {
+	nodes, err := acq.Limit(2).All(setContextOp(ctx, acq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{auditcheckpoint.Label}
+	default:
+		return nil, &NotSingularError{auditcheckpoint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) OnlyX(ctx context.Context) *AuditCheckpoint {
+	node, err := acq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AuditCheckpoint ID in the query.
+// Returns a *NotSingularError when more than one AuditCheckpoint ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (acq *AuditCheckpointQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = acq.Limit(2).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{auditcheckpoint.Label}
+	default:
+		err = &NotSingularError{auditcheckpoint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := acq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AuditCheckpoints.
+func (acq *AuditCheckpointQuery) All(ctx context.Context) ([]*AuditCheckpoint, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryAll)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AuditCheckpoint, *AuditCheckpointQuery]()
+	return withInterceptors[[]*AuditCheckpoint](ctx, acq, qr, acq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) AllX(ctx context.Context) []*AuditCheckpoint {
+	nodes, err := acq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AuditCheckpoint IDs.
+func (acq *AuditCheckpointQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if acq.ctx.Unique == nil && acq.path != nil {
+		acq.Unique(true)
+	}
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryIDs)
+	if err = acq.Select(auditcheckpoint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := acq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (acq *AuditCheckpointQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryCount)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, acq, querierCount[*AuditCheckpointQuery](), acq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) CountX(ctx context.Context) int {
+	count, err := acq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (acq *AuditCheckpointQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryExist)
+	switch _, err := acq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("gen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (acq *AuditCheckpointQuery) ExistX(ctx context.Context) bool {
+	exist, err := acq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AuditCheckpointQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (acq *AuditCheckpointQuery) Clone() *AuditCheckpointQuery {
+	if acq == nil {
+		return nil
+	}
+	return &AuditCheckpointQuery{
+		config:     acq.config,
+		ctx:        acq.ctx.Clone(),
+		order:      append([]auditcheckpoint.OrderOption{}, acq.order...),
+		inters:     append([]Interceptor{}, acq.inters...),
+		predicates: append([]predicate.AuditCheckpoint{}, acq.predicates...),
+		// clone intermediate query.
+		sql:  acq.sql.Clone(),
+		path: acq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AuditLogID uint64 `json:"audit_log_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AuditCheckpoint.Query().
+//		GroupBy(auditcheckpoint.FieldAuditLogID).
+//		Aggregate(gen.Count()).
+//		Scan(ctx, &v)
+func (acq *AuditCheckpointQuery) GroupBy(field string, fields ...string) *AuditCheckpointGroupBy {
+	acq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AuditCheckpointGroupBy{build: acq}
+	grbuild.flds = &acq.ctx.Fields
+	grbuild.label = auditcheckpoint.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AuditLogID uint64 `json:"audit_log_id,omitempty"`
+//	}
+//
+//	client.AuditCheckpoint.Query().
+//		Select(auditcheckpoint.FieldAuditLogID).
+//		Scan(ctx, &v)
+func (acq *AuditCheckpointQuery) Select(fields ...string) *AuditCheckpointSelect {
+	acq.ctx.Fields = append(acq.ctx.Fields, fields...)
+	sbuild := &AuditCheckpointSelect{AuditCheckpointQuery: acq}
+	sbuild.label = auditcheckpoint.Label
+	sbuild.flds, sbuild.scan = &acq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AuditCheckpointSelect configured with the given aggregations.
+func (acq *AuditCheckpointQuery) Aggregate(fns ...AggregateFunc) *AuditCheckpointSelect {
+	return acq.Select().Aggregate(fns...)
+}
+
+func (acq *AuditCheckpointQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range acq.inters {
+		if inter == nil {
+			return fmt.Errorf("gen: uninitialized interceptor (forgotten import gen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, acq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range acq.ctx.Fields {
+		if !auditcheckpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+		}
+	}
+	if acq.path != nil {
+		prev, err := acq.path(ctx)
+		if err != nil {
+			return err
+		}
+		acq.sql = prev
+	}
+	return nil
+}
+
+func (acq *AuditCheckpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AuditCheckpoint, error) {
+	var (
+		nodes = []*AuditCheckpoint{}
+		_spec = acq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AuditCheckpoint).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AuditCheckpoint{config: acq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, acq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (acq *AuditCheckpointQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := acq.querySpec()
+	_spec.Node.Columns = acq.ctx.Fields
+	if len(acq.ctx.Fields) > 0 {
+		_spec.Unique = acq.ctx.Unique != nil && *acq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, acq.driver, _spec)
+}
+
+func (acq *AuditCheckpointQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(auditcheckpoint.Table, auditcheckpoint.Columns, sqlgraph.NewFieldSpec(auditcheckpoint.FieldID, field.TypeUint64))
+	_spec.From = acq.sql
+	if unique := acq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if acq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := acq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditcheckpoint.FieldID)
+		for i := range fields {
+			if fields[i] != auditcheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := acq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := acq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (acq *AuditCheckpointQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(acq.driver.Dialect())
+	t1 := builder.Table(auditcheckpoint.Table)
+	columns := acq.ctx.Fields
+	if len(columns) == 0 {
+		columns = auditcheckpoint.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if acq.sql != nil {
+		selector = acq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if acq.ctx.Unique != nil && *acq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range acq.predicates {
+		p(selector)
+	}
+	for _, p := range acq.order {
+		p(selector)
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AuditCheckpointGroupBy is the group-by builder for AuditCheckpoint entities.
+type AuditCheckpointGroupBy struct {
+	selector
+	build *AuditCheckpointQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (acgb *AuditCheckpointGroupBy) Aggregate(fns ...AggregateFunc) *AuditCheckpointGroupBy {
+	acgb.fns = append(acgb.fns, fns...)
+	return acgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acgb *AuditCheckpointGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acgb.build.ctx, ent.OpQueryGroupBy)
+	if err := acgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuditCheckpointQuery, *AuditCheckpointGroupBy](ctx, acgb.build, acgb, acgb.build.inters, v)
+}
+
+func (acgb *AuditCheckpointGroupBy) sqlScan(ctx context.Context, root *AuditCheckpointQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(acgb.fns))
+	for _, fn := range acgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*acgb.flds)+len(acgb.fns))
+		for _, f := range *acgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*acgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AuditCheckpointSelect is the builder for selecting fields of AuditCheckpoint entities.
+type AuditCheckpointSelect struct {
+	*AuditCheckpointQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acs *AuditCheckpointSelect) Aggregate(fns ...AggregateFunc) *AuditCheckpointSelect {
+	acs.fns = append(acs.fns, fns...)
+	return acs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acs *AuditCheckpointSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acs.ctx, ent.OpQuerySelect)
+	if err := acs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuditCheckpointQuery, *AuditCheckpointSelect](ctx, acs.AuditCheckpointQuery, acs, acs.inters, v)
+}
+
+func (acs *AuditCheckpointSelect) sqlScan(ctx context.Context, root *AuditCheckpointQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acs.fns))
+	for _, fn := range acs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
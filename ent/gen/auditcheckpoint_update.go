@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// AuditCheckpointUpdate is the builder for updating AuditCheckpoint entities.
+type AuditCheckpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditCheckpointMutation
+}
+
+// Where appends a list predicates to the AuditCheckpointUpdate builder.
+func (acu *AuditCheckpointUpdate) Where(ps ...predicate.AuditCheckpoint) *AuditCheckpointUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetAuditLogID sets the "audit_log_id" field.
+func (acu *AuditCheckpointUpdate) SetAuditLogID(u uint64) *AuditCheckpointUpdate {
+	acu.mutation.ResetAuditLogID()
+	acu.mutation.SetAuditLogID(u)
+	return acu
+}
+
+// SetNillableAuditLogID sets the "audit_log_id" field if the given value is not nil.
+func (acu *AuditCheckpointUpdate) SetNillableAuditLogID(u *uint64) *AuditCheckpointUpdate {
+	if u != nil {
+		acu.SetAuditLogID(*u)
+	}
+	return acu
+}
+
+// AddAuditLogID adds u to the "audit_log_id" field.
+func (acu *AuditCheckpointUpdate) AddAuditLogID(u int64) *AuditCheckpointUpdate {
+	acu.mutation.AddAuditLogID(u)
+	return acu
+}
+
+// SetHash sets the "hash" field.
+func (acu *AuditCheckpointUpdate) SetHash(s string) *AuditCheckpointUpdate {
+	acu.mutation.SetHash(s)
+	return acu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (acu *AuditCheckpointUpdate) SetNillableHash(s *string) *AuditCheckpointUpdate {
+	if s != nil {
+		acu.SetHash(*s)
+	}
+	return acu
+}
+
+// SetSignature sets the "signature" field.
+func (acu *AuditCheckpointUpdate) SetSignature(s string) *AuditCheckpointUpdate {
+	acu.mutation.SetSignature(s)
+	return acu
+}
+
+// SetNillableSignature sets the "signature" field if the given value is not nil.
+func (acu *AuditCheckpointUpdate) SetNillableSignature(s *string) *AuditCheckpointUpdate {
+	if s != nil {
+		acu.SetSignature(*s)
+	}
+	return acu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acu *AuditCheckpointUpdate) SetCreatedAt(t time.Time) *AuditCheckpointUpdate {
+	acu.mutation.SetCreatedAt(t)
+	return acu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acu *AuditCheckpointUpdate) SetNillableCreatedAt(t *time.Time) *AuditCheckpointUpdate {
+	if t != nil {
+		acu.SetCreatedAt(*t)
+	}
+	return acu
+}
+
+// Mutation returns the AuditCheckpointMutation object of the builder.
+func (acu *AuditCheckpointUpdate) Mutation() *AuditCheckpointMutation {
+	return acu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AuditCheckpointUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AuditCheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AuditCheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AuditCheckpointUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (acu *AuditCheckpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditcheckpoint.Table, auditcheckpoint.Columns, sqlgraph.NewFieldSpec(auditcheckpoint.FieldID, field.TypeUint64))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.AuditLogID(); ok {
+		_spec.SetField(auditcheckpoint.FieldAuditLogID, field.TypeUint64, value)
+	}
+	if value, ok := acu.mutation.AddedAuditLogID(); ok {
+		_spec.AddField(auditcheckpoint.FieldAuditLogID, field.TypeUint64, value)
+	}
+	if value, ok := acu.mutation.Hash(); ok {
+		_spec.SetField(auditcheckpoint.FieldHash, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.Signature(); ok {
+		_spec.SetField(auditcheckpoint.FieldSignature, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.CreatedAt(); ok {
+		_spec.SetField(auditcheckpoint.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// AuditCheckpointUpdateOne is the builder for updating a single AuditCheckpoint entity.
+type AuditCheckpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditCheckpointMutation
+}
+
+// SetAuditLogID sets the "audit_log_id" field.
+func (acuo *AuditCheckpointUpdateOne) SetAuditLogID(u uint64) *AuditCheckpointUpdateOne {
+	acuo.mutation.ResetAuditLogID()
+	acuo.mutation.SetAuditLogID(u)
+	return acuo
+}
+
+// SetNillableAuditLogID sets the "audit_log_id" field if the given value is not nil.
+func (acuo *AuditCheckpointUpdateOne) SetNillableAuditLogID(u *uint64) *AuditCheckpointUpdateOne {
+	if u != nil {
+		acuo.SetAuditLogID(*u)
+	}
+	return acuo
+}
+
+// AddAuditLogID adds u to the "audit_log_id" field.
+func (acuo *AuditCheckpointUpdateOne) AddAuditLogID(u int64) *AuditCheckpointUpdateOne {
+	acuo.mutation.AddAuditLogID(u)
+	return acuo
+}
+
+// SetHash sets the "hash" field.
+func (acuo *AuditCheckpointUpdateOne) SetHash(s string) *AuditCheckpointUpdateOne {
+	acuo.mutation.SetHash(s)
+	return acuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (acuo *AuditCheckpointUpdateOne) SetNillableHash(s *string) *AuditCheckpointUpdateOne {
+	if s != nil {
+		acuo.SetHash(*s)
+	}
+	return acuo
+}
+
+// SetSignature sets the "signature" field.
+func (acuo *AuditCheckpointUpdateOne) SetSignature(s string) *AuditCheckpointUpdateOne {
+	acuo.mutation.SetSignature(s)
+	return acuo
+}
+
+// SetNillableSignature sets the "signature" field if the given value is not nil.
+func (acuo *AuditCheckpointUpdateOne) SetNillableSignature(s *string) *AuditCheckpointUpdateOne {
+	if s != nil {
+		acuo.SetSignature(*s)
+	}
+	return acuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acuo *AuditCheckpointUpdateOne) SetCreatedAt(t time.Time) *AuditCheckpointUpdateOne {
+	acuo.mutation.SetCreatedAt(t)
+	return acuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acuo *AuditCheckpointUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditCheckpointUpdateOne {
+	if t != nil {
+		acuo.SetCreatedAt(*t)
+	}
+	return acuo
+}
+
+// Mutation returns the AuditCheckpointMutation object of the builder.
+func (acuo *AuditCheckpointUpdateOne) Mutation() *AuditCheckpointMutation {
+	return acuo.mutation
+}
+
+// Where appends a list predicates to the AuditCheckpointUpdate builder.
+func (acuo *AuditCheckpointUpdateOne) Where(ps ...predicate.AuditCheckpoint) *AuditCheckpointUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *AuditCheckpointUpdateOne) Select(field string, fields ...string) *AuditCheckpointUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated AuditCheckpoint entity.
+func (acuo *AuditCheckpointUpdateOne) Save(ctx context.Context) (*AuditCheckpoint, error) {
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AuditCheckpointUpdateOne) SaveX(ctx context.Context) *AuditCheckpoint {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AuditCheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AuditCheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (acuo *AuditCheckpointUpdateOne) sqlSave(ctx context.Context) (_node *AuditCheckpoint, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditcheckpoint.Table, auditcheckpoint.Columns, sqlgraph.NewFieldSpec(auditcheckpoint.FieldID, field.TypeUint64))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "AuditCheckpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditcheckpoint.FieldID)
+		for _, f := range fields {
+			if !auditcheckpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != auditcheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.AuditLogID(); ok {
+		_spec.SetField(auditcheckpoint.FieldAuditLogID, field.TypeUint64, value)
+	}
+	if value, ok := acuo.mutation.AddedAuditLogID(); ok {
+		_spec.AddField(auditcheckpoint.FieldAuditLogID, field.TypeUint64, value)
+	}
+	if value, ok := acuo.mutation.Hash(); ok {
+		_spec.SetField(auditcheckpoint.FieldHash, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.Signature(); ok {
+		_spec.SetField(auditcheckpoint.FieldSignature, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.CreatedAt(); ok {
+		_spec.SetField(auditcheckpoint.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &AuditCheckpoint{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
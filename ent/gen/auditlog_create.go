@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(u uint64) *AuditLogCreate {
+	alc.mutation.SetActorID(u)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetActorID(*u)
+	}
+	return alc
+}
+
+// SetTableName sets the "table_name" field.
+func (alc *AuditLogCreate) SetTableName(s string) *AuditLogCreate {
+	alc.mutation.SetTableName(s)
+	return alc
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (alc *AuditLogCreate) SetTableRowID(u uint64) *AuditLogCreate {
+	alc.mutation.SetTableRowID(u)
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetOldValues sets the "old_values" field.
+func (alc *AuditLogCreate) SetOldValues(j jsontext.Value) *AuditLogCreate {
+	alc.mutation.SetOldValues(j)
+	return alc
+}
+
+// SetNewValues sets the "new_values" field.
+func (alc *AuditLogCreate) SetNewValues(j jsontext.Value) *AuditLogCreate {
+	alc.mutation.SetNewValues(j)
+	return alc
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (alc *AuditLogCreate) SetHTTPMethod(s string) *AuditLogCreate {
+	alc.mutation.SetHTTPMethod(s)
+	return alc
+}
+
+// SetNillableHTTPMethod sets the "http_method" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableHTTPMethod(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetHTTPMethod(*s)
+	}
+	return alc
+}
+
+// SetURL sets the "url" field.
+func (alc *AuditLogCreate) SetURL(s string) *AuditLogCreate {
+	alc.mutation.SetURL(s)
+	return alc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableURL(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetURL(*s)
+	}
+	return alc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (alc *AuditLogCreate) SetIPAddress(s string) *AuditLogCreate {
+	alc.mutation.SetIPAddress(s)
+	return alc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableIPAddress(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetIPAddress(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (alc *AuditLogCreate) SetPrevHash(s string) *AuditLogCreate {
+	alc.mutation.SetPrevHash(s)
+	return alc
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePrevHash(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPrevHash(*s)
+	}
+	return alc
+}
+
+// SetHash sets the "hash" field.
+func (alc *AuditLogCreate) SetHash(s string) *AuditLogCreate {
+	alc.mutation.SetHash(s)
+	return alc
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableHash(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetHash(*s)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uint64) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`gen: missing required field "AuditLog.table_name"`)}
+	}
+	if _, ok := alc.mutation.TableRowID(); !ok {
+		return &ValidationError{Name: "table_row_id", err: errors.New(`gen: missing required field "AuditLog.table_row_id"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`gen: missing required field "AuditLog.action"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	)
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+		_node.ActorID = &value
+	}
+	if value, ok := alc.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := alc.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+		_node.TableRowID = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeJSON, value)
+		_node.OldValues = value
+	}
+	if value, ok := alc.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeJSON, value)
+		_node.NewValues = value
+	}
+	if value, ok := alc.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+		_node.HTTPMethod = value
+	}
+	if value, ok := alc.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := alc.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := alc.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = &value
+	}
+	if value, ok := alc.mutation.Hash(); ok {
+		_spec.SetField(auditlog.FieldHash, field.TypeString, value)
+		_node.Hash = &value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetActorID sets the "actor_id" field.
+func (alu *AuditLogUpdate) SetActorID(u uint64) *AuditLogUpdate {
+	alu.mutation.ResetActorID()
+	alu.mutation.SetActorID(u)
+	return alu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActorID(u *uint64) *AuditLogUpdate {
+	if u != nil {
+		alu.SetActorID(*u)
+	}
+	return alu
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (alu *AuditLogUpdate) AddActorID(u int64) *AuditLogUpdate {
+	alu.mutation.AddActorID(u)
+	return alu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (alu *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	alu.mutation.ClearActorID()
+	return alu
+}
+
+// SetTableName sets the "table_name" field.
+func (alu *AuditLogUpdate) SetTableName(s string) *AuditLogUpdate {
+	alu.mutation.SetTableName(s)
+	return alu
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTableName(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTableName(*s)
+	}
+	return alu
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (alu *AuditLogUpdate) SetTableRowID(u uint64) *AuditLogUpdate {
+	alu.mutation.ResetTableRowID()
+	alu.mutation.SetTableRowID(u)
+	return alu
+}
+
+// SetNillableTableRowID sets the "table_row_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTableRowID(u *uint64) *AuditLogUpdate {
+	if u != nil {
+		alu.SetTableRowID(*u)
+	}
+	return alu
+}
+
+// AddTableRowID adds u to the "table_row_id" field.
+func (alu *AuditLogUpdate) AddTableRowID(u int64) *AuditLogUpdate {
+	alu.mutation.AddTableRowID(u)
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAction(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAction(*s)
+	}
+	return alu
+}
+
+// SetOldValues sets the "old_values" field.
+func (alu *AuditLogUpdate) SetOldValues(j jsontext.Value) *AuditLogUpdate {
+	alu.mutation.SetOldValues(j)
+	return alu
+}
+
+// AppendOldValues appends j to the "old_values" field.
+func (alu *AuditLogUpdate) AppendOldValues(j jsontext.Value) *AuditLogUpdate {
+	alu.mutation.AppendOldValues(j)
+	return alu
+}
+
+// ClearOldValues clears the value of the "old_values" field.
+func (alu *AuditLogUpdate) ClearOldValues() *AuditLogUpdate {
+	alu.mutation.ClearOldValues()
+	return alu
+}
+
+// SetNewValues sets the "new_values" field.
+func (alu *AuditLogUpdate) SetNewValues(j jsontext.Value) *AuditLogUpdate {
+	alu.mutation.SetNewValues(j)
+	return alu
+}
+
+// AppendNewValues appends j to the "new_values" field.
+func (alu *AuditLogUpdate) AppendNewValues(j jsontext.Value) *AuditLogUpdate {
+	alu.mutation.AppendNewValues(j)
+	return alu
+}
+
+// ClearNewValues clears the value of the "new_values" field.
+func (alu *AuditLogUpdate) ClearNewValues() *AuditLogUpdate {
+	alu.mutation.ClearNewValues()
+	return alu
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (alu *AuditLogUpdate) SetHTTPMethod(s string) *AuditLogUpdate {
+	alu.mutation.SetHTTPMethod(s)
+	return alu
+}
+
+// SetNillableHTTPMethod sets the "http_method" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableHTTPMethod(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetHTTPMethod(*s)
+	}
+	return alu
+}
+
+// ClearHTTPMethod clears the value of the "http_method" field.
+func (alu *AuditLogUpdate) ClearHTTPMethod() *AuditLogUpdate {
+	alu.mutation.ClearHTTPMethod()
+	return alu
+}
+
+// SetURL sets the "url" field.
+func (alu *AuditLogUpdate) SetURL(s string) *AuditLogUpdate {
+	alu.mutation.SetURL(s)
+	return alu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableURL(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetURL(*s)
+	}
+	return alu
+}
+
+// ClearURL clears the value of the "url" field.
+func (alu *AuditLogUpdate) ClearURL() *AuditLogUpdate {
+	alu.mutation.ClearURL()
+	return alu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (alu *AuditLogUpdate) SetIPAddress(s string) *AuditLogUpdate {
+	alu.mutation.SetIPAddress(s)
+	return alu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIPAddress(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIPAddress(*s)
+	}
+	return alu
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (alu *AuditLogUpdate) ClearIPAddress() *AuditLogUpdate {
+	alu.mutation.ClearIPAddress()
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (alu *AuditLogUpdate) ClearUserAgent() *AuditLogUpdate {
+	alu.mutation.ClearUserAgent()
+	return alu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alu *AuditLogUpdate) SetCreatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetCreatedAt(t)
+	return alu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCreatedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetCreatedAt(*t)
+	}
+	return alu
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (alu *AuditLogUpdate) SetPrevHash(s string) *AuditLogUpdate {
+	alu.mutation.SetPrevHash(s)
+	return alu
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePrevHash(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPrevHash(*s)
+	}
+	return alu
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (alu *AuditLogUpdate) ClearPrevHash() *AuditLogUpdate {
+	alu.mutation.ClearPrevHash()
+	return alu
+}
+
+// SetHash sets the "hash" field.
+func (alu *AuditLogUpdate) SetHash(s string) *AuditLogUpdate {
+	alu.mutation.SetHash(s)
+	return alu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableHash(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetHash(*s)
+	}
+	return alu
+}
+
+// ClearHash clears the value of the "hash" field.
+func (alu *AuditLogUpdate) ClearHash() *AuditLogUpdate {
+	alu.mutation.ClearHash()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if value, ok := alu.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if alu.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUint64)
+	}
+	if value, ok := alu.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := alu.mutation.AddedTableRowID(); ok {
+		_spec.AddField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeJSON, value)
+	}
+	if value, ok := alu.mutation.AppendedOldValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldOldValues, value)
+		})
+	}
+	if alu.mutation.OldValuesCleared() {
+		_spec.ClearField(auditlog.FieldOldValues, field.TypeJSON)
+	}
+	if value, ok := alu.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeJSON, value)
+	}
+	if value, ok := alu.mutation.AppendedNewValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldNewValues, value)
+		})
+	}
+	if alu.mutation.NewValuesCleared() {
+		_spec.ClearField(auditlog.FieldNewValues, field.TypeJSON)
+	}
+	if value, ok := alu.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+	}
+	if alu.mutation.HTTPMethodCleared() {
+		_spec.ClearField(auditlog.FieldHTTPMethod, field.TypeString)
+	}
+	if value, ok := alu.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+	}
+	if alu.mutation.URLCleared() {
+		_spec.ClearField(auditlog.FieldURL, field.TypeString)
+	}
+	if value, ok := alu.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+	}
+	if alu.mutation.IPAddressCleared() {
+		_spec.ClearField(auditlog.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if alu.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := alu.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := alu.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if alu.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if value, ok := alu.mutation.Hash(); ok {
+		_spec.SetField(auditlog.FieldHash, field.TypeString, value)
+	}
+	if alu.mutation.HashCleared() {
+		_spec.ClearField(auditlog.FieldHash, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetActorID sets the "actor_id" field.
+func (aluo *AuditLogUpdateOne) SetActorID(u uint64) *AuditLogUpdateOne {
+	aluo.mutation.ResetActorID()
+	aluo.mutation.SetActorID(u)
+	return aluo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActorID(u *uint64) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetActorID(*u)
+	}
+	return aluo
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (aluo *AuditLogUpdateOne) AddActorID(u int64) *AuditLogUpdateOne {
+	aluo.mutation.AddActorID(u)
+	return aluo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aluo *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	aluo.mutation.ClearActorID()
+	return aluo
+}
+
+// SetTableName sets the "table_name" field.
+func (aluo *AuditLogUpdateOne) SetTableName(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTableName(s)
+	return aluo
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTableName(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTableName(*s)
+	}
+	return aluo
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (aluo *AuditLogUpdateOne) SetTableRowID(u uint64) *AuditLogUpdateOne {
+	aluo.mutation.ResetTableRowID()
+	aluo.mutation.SetTableRowID(u)
+	return aluo
+}
+
+// SetNillableTableRowID sets the "table_row_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTableRowID(u *uint64) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetTableRowID(*u)
+	}
+	return aluo
+}
+
+// AddTableRowID adds u to the "table_row_id" field.
+func (aluo *AuditLogUpdateOne) AddTableRowID(u int64) *AuditLogUpdateOne {
+	aluo.mutation.AddTableRowID(u)
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAction(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAction(*s)
+	}
+	return aluo
+}
+
+// SetOldValues sets the "old_values" field.
+func (aluo *AuditLogUpdateOne) SetOldValues(j jsontext.Value) *AuditLogUpdateOne {
+	aluo.mutation.SetOldValues(j)
+	return aluo
+}
+
+// AppendOldValues appends j to the "old_values" field.
+func (aluo *AuditLogUpdateOne) AppendOldValues(j jsontext.Value) *AuditLogUpdateOne {
+	aluo.mutation.AppendOldValues(j)
+	return aluo
+}
+
+// ClearOldValues clears the value of the "old_values" field.
+func (aluo *AuditLogUpdateOne) ClearOldValues() *AuditLogUpdateOne {
+	aluo.mutation.ClearOldValues()
+	return aluo
+}
+
+// SetNewValues sets the "new_values" field.
+func (aluo *AuditLogUpdateOne) SetNewValues(j jsontext.Value) *AuditLogUpdateOne {
+	aluo.mutation.SetNewValues(j)
+	return aluo
+}
+
+// AppendNewValues appends j to the "new_values" field.
+func (aluo *AuditLogUpdateOne) AppendNewValues(j jsontext.Value) *AuditLogUpdateOne {
+	aluo.mutation.AppendNewValues(j)
+	return aluo
+}
+
+// ClearNewValues clears the value of the "new_values" field.
+func (aluo *AuditLogUpdateOne) ClearNewValues() *AuditLogUpdateOne {
+	aluo.mutation.ClearNewValues()
+	return aluo
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (aluo *AuditLogUpdateOne) SetHTTPMethod(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetHTTPMethod(s)
+	return aluo
+}
+
+// SetNillableHTTPMethod sets the "http_method" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableHTTPMethod(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetHTTPMethod(*s)
+	}
+	return aluo
+}
+
+// ClearHTTPMethod clears the value of the "http_method" field.
+func (aluo *AuditLogUpdateOne) ClearHTTPMethod() *AuditLogUpdateOne {
+	aluo.mutation.ClearHTTPMethod()
+	return aluo
+}
+
+// SetURL sets the "url" field.
+func (aluo *AuditLogUpdateOne) SetURL(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetURL(s)
+	return aluo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableURL(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetURL(*s)
+	}
+	return aluo
+}
+
+// ClearURL clears the value of the "url" field.
+func (aluo *AuditLogUpdateOne) ClearURL() *AuditLogUpdateOne {
+	aluo.mutation.ClearURL()
+	return aluo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (aluo *AuditLogUpdateOne) SetIPAddress(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIPAddress(s)
+	return aluo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIPAddress(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIPAddress(*s)
+	}
+	return aluo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (aluo *AuditLogUpdateOne) ClearIPAddress() *AuditLogUpdateOne {
+	aluo.mutation.ClearIPAddress()
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (aluo *AuditLogUpdateOne) ClearUserAgent() *AuditLogUpdateOne {
+	aluo.mutation.ClearUserAgent()
+	return aluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aluo *AuditLogUpdateOne) SetCreatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetCreatedAt(t)
+	return aluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetCreatedAt(*t)
+	}
+	return aluo
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aluo *AuditLogUpdateOne) SetPrevHash(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPrevHash(s)
+	return aluo
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePrevHash(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPrevHash(*s)
+	}
+	return aluo
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (aluo *AuditLogUpdateOne) ClearPrevHash() *AuditLogUpdateOne {
+	aluo.mutation.ClearPrevHash()
+	return aluo
+}
+
+// SetHash sets the "hash" field.
+func (aluo *AuditLogUpdateOne) SetHash(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetHash(s)
+	return aluo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableHash(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetHash(*s)
+	}
+	return aluo
+}
+
+// ClearHash clears the value of the "hash" field.
+func (aluo *AuditLogUpdateOne) ClearHash() *AuditLogUpdateOne {
+	aluo.mutation.ClearHash()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if value, ok := aluo.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if aluo.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUint64)
+	}
+	if value, ok := aluo.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := aluo.mutation.AddedTableRowID(); ok {
+		_spec.AddField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeJSON, value)
+	}
+	if value, ok := aluo.mutation.AppendedOldValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldOldValues, value)
+		})
+	}
+	if aluo.mutation.OldValuesCleared() {
+		_spec.ClearField(auditlog.FieldOldValues, field.TypeJSON)
+	}
+	if value, ok := aluo.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeJSON, value)
+	}
+	if value, ok := aluo.mutation.AppendedNewValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldNewValues, value)
+		})
+	}
+	if aluo.mutation.NewValuesCleared() {
+		_spec.ClearField(auditlog.FieldNewValues, field.TypeJSON)
+	}
+	if value, ok := aluo.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+	}
+	if aluo.mutation.HTTPMethodCleared() {
+		_spec.ClearField(auditlog.FieldHTTPMethod, field.TypeString)
+	}
+	if value, ok := aluo.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+	}
+	if aluo.mutation.URLCleared() {
+		_spec.ClearField(auditlog.FieldURL, field.TypeString)
+	}
+	if value, ok := aluo.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+	}
+	if aluo.mutation.IPAddressCleared() {
+		_spec.ClearField(auditlog.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if aluo.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := aluo.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := aluo.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if aluo.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Hash(); ok {
+		_spec.SetField(auditlog.FieldHash, field.TypeString, value)
+	}
+	if aluo.mutation.HashCleared() {
+		_spec.ClearField(auditlog.FieldHash, field.TypeString)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
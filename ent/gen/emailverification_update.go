@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/emailverification"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// EmailVerificationUpdate is the builder for updating EmailVerification entities.
+type EmailVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evu *EmailVerificationUpdate) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdate {
+	evu.mutation.Where(ps...)
+	return evu
+}
+
+// SetUserID sets the "user_id" field.
+func (evu *EmailVerificationUpdate) SetUserID(u uint64) *EmailVerificationUpdate {
+	evu.mutation.ResetUserID()
+	evu.mutation.SetUserID(u)
+	return evu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableUserID(u *uint64) *EmailVerificationUpdate {
+	if u != nil {
+		evu.SetUserID(*u)
+	}
+	return evu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (evu *EmailVerificationUpdate) AddUserID(u int64) *EmailVerificationUpdate {
+	evu.mutation.AddUserID(u)
+	return evu
+}
+
+// SetEmail sets the "email" field.
+func (evu *EmailVerificationUpdate) SetEmail(s string) *EmailVerificationUpdate {
+	evu.mutation.SetEmail(s)
+	return evu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableEmail(s *string) *EmailVerificationUpdate {
+	if s != nil {
+		evu.SetEmail(*s)
+	}
+	return evu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evu *EmailVerificationUpdate) SetTokenHash(s string) *EmailVerificationUpdate {
+	evu.mutation.SetTokenHash(s)
+	return evu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableTokenHash(s *string) *EmailVerificationUpdate {
+	if s != nil {
+		evu.SetTokenHash(*s)
+	}
+	return evu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (evu *EmailVerificationUpdate) SetExpiresAt(t time.Time) *EmailVerificationUpdate {
+	evu.mutation.SetExpiresAt(t)
+	return evu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableExpiresAt(t *time.Time) *EmailVerificationUpdate {
+	if t != nil {
+		evu.SetExpiresAt(*t)
+	}
+	return evu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (evu *EmailVerificationUpdate) SetCreatedAt(t time.Time) *EmailVerificationUpdate {
+	evu.mutation.SetCreatedAt(t)
+	return evu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableCreatedAt(t *time.Time) *EmailVerificationUpdate {
+	if t != nil {
+		evu.SetCreatedAt(*t)
+	}
+	return evu
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evu *EmailVerificationUpdate) Mutation() *EmailVerificationMutation {
+	return evu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evu *EmailVerificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, evu.sqlSave, evu.mutation, evu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := evu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evu *EmailVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := evu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) ExecX(ctx context.Context) {
+	if err := evu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (evu *EmailVerificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeUint64))
+	if ps := evu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evu.mutation.UserID(); ok {
+		_spec.SetField(emailverification.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := evu.mutation.AddedUserID(); ok {
+		_spec.AddField(emailverification.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := evu.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := evu.mutation.TokenHash(); ok {
+		_spec.SetField(emailverification.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := evu.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverification.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := evu.mutation.CreatedAt(); ok {
+		_spec.SetField(emailverification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, evu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	evu.mutation.done = true
+	return n, nil
+}
+
+// EmailVerificationUpdateOne is the builder for updating a single EmailVerification entity.
+type EmailVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (evuo *EmailVerificationUpdateOne) SetUserID(u uint64) *EmailVerificationUpdateOne {
+	evuo.mutation.ResetUserID()
+	evuo.mutation.SetUserID(u)
+	return evuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableUserID(u *uint64) *EmailVerificationUpdateOne {
+	if u != nil {
+		evuo.SetUserID(*u)
+	}
+	return evuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (evuo *EmailVerificationUpdateOne) AddUserID(u int64) *EmailVerificationUpdateOne {
+	evuo.mutation.AddUserID(u)
+	return evuo
+}
+
+// SetEmail sets the "email" field.
+func (evuo *EmailVerificationUpdateOne) SetEmail(s string) *EmailVerificationUpdateOne {
+	evuo.mutation.SetEmail(s)
+	return evuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableEmail(s *string) *EmailVerificationUpdateOne {
+	if s != nil {
+		evuo.SetEmail(*s)
+	}
+	return evuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evuo *EmailVerificationUpdateOne) SetTokenHash(s string) *EmailVerificationUpdateOne {
+	evuo.mutation.SetTokenHash(s)
+	return evuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableTokenHash(s *string) *EmailVerificationUpdateOne {
+	if s != nil {
+		evuo.SetTokenHash(*s)
+	}
+	return evuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (evuo *EmailVerificationUpdateOne) SetExpiresAt(t time.Time) *EmailVerificationUpdateOne {
+	evuo.mutation.SetExpiresAt(t)
+	return evuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableExpiresAt(t *time.Time) *EmailVerificationUpdateOne {
+	if t != nil {
+		evuo.SetExpiresAt(*t)
+	}
+	return evuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (evuo *EmailVerificationUpdateOne) SetCreatedAt(t time.Time) *EmailVerificationUpdateOne {
+	evuo.mutation.SetCreatedAt(t)
+	return evuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableCreatedAt(t *time.Time) *EmailVerificationUpdateOne {
+	if t != nil {
+		evuo.SetCreatedAt(*t)
+	}
+	return evuo
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evuo *EmailVerificationUpdateOne) Mutation() *EmailVerificationMutation {
+	return evuo.mutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evuo *EmailVerificationUpdateOne) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdateOne {
+	evuo.mutation.Where(ps...)
+	return evuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evuo *EmailVerificationUpdateOne) Select(field string, fields ...string) *EmailVerificationUpdateOne {
+	evuo.fields = append([]string{field}, fields...)
+	return evuo
+}
+
+// Save executes the query and returns the updated EmailVerification entity.
+func (evuo *EmailVerificationUpdateOne) Save(ctx context.Context) (*EmailVerification, error) {
+	return withHooks(ctx, evuo.sqlSave, evuo.mutation, evuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) SaveX(ctx context.Context) *EmailVerification {
+	node, err := evuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evuo *EmailVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := evuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := evuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (evuo *EmailVerificationUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerification, err error) {
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeUint64))
+	id, ok := evuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "EmailVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for _, f := range fields {
+			if !emailverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evuo.mutation.UserID(); ok {
+		_spec.SetField(emailverification.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := evuo.mutation.AddedUserID(); ok {
+		_spec.AddField(emailverification.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := evuo.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := evuo.mutation.TokenHash(); ok {
+		_spec.SetField(emailverification.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := evuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverification.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := evuo.mutation.CreatedAt(); ok {
+		_spec.SetField(emailverification.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &EmailVerification{config: evuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	evuo.mutation.done = true
+	return _node, nil
+}
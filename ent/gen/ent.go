@@ -12,10 +12,19 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
 	"github.com/gmhafiz/go8/ent/gen/author"
 	"github.com/gmhafiz/go8/ent/gen/book"
+	"github.com/gmhafiz/go8/ent/gen/emailverification"
+	"github.com/gmhafiz/go8/ent/gen/invitation"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/loginevent"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+	"github.com/gmhafiz/go8/ent/gen/personalaccesstoken"
 	"github.com/gmhafiz/go8/ent/gen/session"
 	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/ent/gen/useridentity"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			author.Table:  author.ValidColumn,
-			book.Table:    book.ValidColumn,
-			session.Table: session.ValidColumn,
-			user.Table:    user.ValidColumn,
+			auditcheckpoint.Table:     auditcheckpoint.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			author.Table:              author.ValidColumn,
+			book.Table:                book.ValidColumn,
+			emailverification.Table:   emailverification.ValidColumn,
+			invitation.Table:          invitation.ValidColumn,
+			invitationuse.Table:       invitationuse.ValidColumn,
+			loginevent.Table:          loginevent.ValidColumn,
+			magiclink.Table:           magiclink.ValidColumn,
+			personalaccesstoken.Table: personalaccesstoken.ValidColumn,
+			session.Table:             session.ValidColumn,
+			user.Table:                user.ValidColumn,
+			useridentity.Table:        useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
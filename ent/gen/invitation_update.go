@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/invitation"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (iu *InvitationUpdate) SetCodeHash(s string) *InvitationUpdate {
+	iu.mutation.SetCodeHash(s)
+	return iu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCodeHash(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetCodeHash(*s)
+	}
+	return iu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iu *InvitationUpdate) SetCreatedBy(u uint64) *InvitationUpdate {
+	iu.mutation.ResetCreatedBy()
+	iu.mutation.SetCreatedBy(u)
+	return iu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatedBy(u *uint64) *InvitationUpdate {
+	if u != nil {
+		iu.SetCreatedBy(*u)
+	}
+	return iu
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (iu *InvitationUpdate) AddCreatedBy(u int64) *InvitationUpdate {
+	iu.mutation.AddCreatedBy(u)
+	return iu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (iu *InvitationUpdate) ClearCreatedBy() *InvitationUpdate {
+	iu.mutation.ClearCreatedBy()
+	return iu
+}
+
+// SetRoles sets the "roles" field.
+func (iu *InvitationUpdate) SetRoles(s []string) *InvitationUpdate {
+	iu.mutation.SetRoles(s)
+	return iu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (iu *InvitationUpdate) AppendRoles(s []string) *InvitationUpdate {
+	iu.mutation.AppendRoles(s)
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InvitationUpdate) SetMaxUses(i int) *InvitationUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableMaxUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InvitationUpdate) AddMaxUses(i int) *InvitationUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// SetUses sets the "uses" field.
+func (iu *InvitationUpdate) SetUses(i int) *InvitationUpdate {
+	iu.mutation.ResetUses()
+	iu.mutation.SetUses(i)
+	return iu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetUses(*i)
+	}
+	return iu
+}
+
+// AddUses adds i to the "uses" field.
+func (iu *InvitationUpdate) AddUses(i int) *InvitationUpdate {
+	iu.mutation.AddUses(i)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iu *InvitationUpdate) SetRevokedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetRevokedAt(t)
+	return iu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRevokedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetRevokedAt(*t)
+	}
+	return iu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iu *InvitationUpdate) ClearRevokedAt() *InvitationUpdate {
+	iu.mutation.ClearRevokedAt()
+	return iu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iu *InvitationUpdate) SetCreatedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetCreatedAt(*t)
+	}
+	return iu
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUint64))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.CodeHash(); ok {
+		_spec.SetField(invitation.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.CreatedBy(); ok {
+		_spec.SetField(invitation.FieldCreatedBy, field.TypeUint64, value)
+	}
+	if value, ok := iu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(invitation.FieldCreatedBy, field.TypeUint64, value)
+	}
+	if iu.mutation.CreatedByCleared() {
+		_spec.ClearField(invitation.FieldCreatedBy, field.TypeUint64)
+	}
+	if value, ok := iu.mutation.Roles(); ok {
+		_spec.SetField(invitation.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitation.FieldRoles, value)
+		})
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (iuo *InvitationUpdateOne) SetCodeHash(s string) *InvitationUpdateOne {
+	iuo.mutation.SetCodeHash(s)
+	return iuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCodeHash(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetCodeHash(*s)
+	}
+	return iuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iuo *InvitationUpdateOne) SetCreatedBy(u uint64) *InvitationUpdateOne {
+	iuo.mutation.ResetCreatedBy()
+	iuo.mutation.SetCreatedBy(u)
+	return iuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatedBy(u *uint64) *InvitationUpdateOne {
+	if u != nil {
+		iuo.SetCreatedBy(*u)
+	}
+	return iuo
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (iuo *InvitationUpdateOne) AddCreatedBy(u int64) *InvitationUpdateOne {
+	iuo.mutation.AddCreatedBy(u)
+	return iuo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (iuo *InvitationUpdateOne) ClearCreatedBy() *InvitationUpdateOne {
+	iuo.mutation.ClearCreatedBy()
+	return iuo
+}
+
+// SetRoles sets the "roles" field.
+func (iuo *InvitationUpdateOne) SetRoles(s []string) *InvitationUpdateOne {
+	iuo.mutation.SetRoles(s)
+	return iuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (iuo *InvitationUpdateOne) AppendRoles(s []string) *InvitationUpdateOne {
+	iuo.mutation.AppendRoles(s)
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InvitationUpdateOne) SetMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableMaxUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InvitationUpdateOne) AddMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// SetUses sets the "uses" field.
+func (iuo *InvitationUpdateOne) SetUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetUses()
+	iuo.mutation.SetUses(i)
+	return iuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetUses(*i)
+	}
+	return iuo
+}
+
+// AddUses adds i to the "uses" field.
+func (iuo *InvitationUpdateOne) AddUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddUses(i)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iuo *InvitationUpdateOne) SetRevokedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetRevokedAt(t)
+	return iuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRevokedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetRevokedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iuo *InvitationUpdateOne) ClearRevokedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearRevokedAt()
+	return iuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iuo *InvitationUpdateOne) SetCreatedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetCreatedAt(*t)
+	}
+	return iuo
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUint64))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.CodeHash(); ok {
+		_spec.SetField(invitation.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.CreatedBy(); ok {
+		_spec.SetField(invitation.FieldCreatedBy, field.TypeUint64, value)
+	}
+	if value, ok := iuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(invitation.FieldCreatedBy, field.TypeUint64, value)
+	}
+	if iuo.mutation.CreatedByCleared() {
+		_spec.ClearField(invitation.FieldCreatedBy, field.TypeUint64)
+	}
+	if value, ok := iuo.mutation.Roles(); ok {
+		_spec.SetField(invitation.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitation.FieldRoles, value)
+		})
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+)
+
+// InvitationUse is the model entity for the InvitationUse schema.
+type InvitationUse struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// InvitationID holds the value of the "invitation_id" field.
+	InvitationID uint64 `json:"invitation_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt       time.Time `json:"used_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvitationUse) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitationuse.FieldID, invitationuse.FieldInvitationID, invitationuse.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case invitationuse.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvitationUse fields.
+func (iu *InvitationUse) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invitationuse.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			iu.ID = uint64(value.Int64)
+		case invitationuse.FieldInvitationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invitation_id", values[i])
+			} else if value.Valid {
+				iu.InvitationID = uint64(value.Int64)
+			}
+		case invitationuse.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				iu.UserID = uint64(value.Int64)
+			}
+		case invitationuse.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				iu.UsedAt = value.Time
+			}
+		default:
+			iu.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvitationUse.
+// This includes values selected through modifiers, order, etc.
+func (iu *InvitationUse) Value(name string) (ent.Value, error) {
+	return iu.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InvitationUse.
+// Note that you need to call InvitationUse.Unwrap() before calling this method if this InvitationUse
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (iu *InvitationUse) Update() *InvitationUseUpdateOne {
+	return NewInvitationUseClient(iu.config).UpdateOne(iu)
+}
+
+// Unwrap unwraps the InvitationUse entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (iu *InvitationUse) Unwrap() *InvitationUse {
+	_tx, ok := iu.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: InvitationUse is not a transactional entity")
+	}
+	iu.config.driver = _tx.drv
+	return iu
+}
+
+// String implements the fmt.Stringer.
+func (iu *InvitationUse) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvitationUse(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", iu.ID))
+	builder.WriteString("invitation_id=")
+	builder.WriteString(fmt.Sprintf("%v", iu.InvitationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", iu.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("used_at=")
+	builder.WriteString(iu.UsedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvitationUses is a parsable slice of InvitationUse.
+type InvitationUses []*InvitationUse
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invitationuse
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLTE(FieldID, id))
+}
+
+// InvitationID applies equality check predicate on the "invitation_id" field. It's identical to InvitationIDEQ.
+func InvitationID(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldInvitationID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldUserID, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// InvitationIDEQ applies the EQ predicate on the "invitation_id" field.
+func InvitationIDEQ(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldInvitationID, v))
+}
+
+// InvitationIDNEQ applies the NEQ predicate on the "invitation_id" field.
+func InvitationIDNEQ(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNEQ(FieldInvitationID, v))
+}
+
+// InvitationIDIn applies the In predicate on the "invitation_id" field.
+func InvitationIDIn(vs ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldIn(FieldInvitationID, vs...))
+}
+
+// InvitationIDNotIn applies the NotIn predicate on the "invitation_id" field.
+func InvitationIDNotIn(vs ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNotIn(FieldInvitationID, vs...))
+}
+
+// InvitationIDGT applies the GT predicate on the "invitation_id" field.
+func InvitationIDGT(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGT(FieldInvitationID, v))
+}
+
+// InvitationIDGTE applies the GTE predicate on the "invitation_id" field.
+func InvitationIDGTE(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGTE(FieldInvitationID, v))
+}
+
+// InvitationIDLT applies the LT predicate on the "invitation_id" field.
+func InvitationIDLT(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLT(FieldInvitationID, v))
+}
+
+// InvitationIDLTE applies the LTE predicate on the "invitation_id" field.
+func InvitationIDLTE(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLTE(FieldInvitationID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLTE(FieldUserID, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvitationUse) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvitationUse) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvitationUse) predicate.InvitationUse {
+	return predicate.InvitationUse(sql.NotPredicates(p))
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+)
+
+// InvitationUseCreate is the builder for creating a InvitationUse entity.
+type InvitationUseCreate struct {
+	config
+	mutation *InvitationUseMutation
+	hooks    []Hook
+}
+
+// SetInvitationID sets the "invitation_id" field.
+func (iuc *InvitationUseCreate) SetInvitationID(u uint64) *InvitationUseCreate {
+	iuc.mutation.SetInvitationID(u)
+	return iuc
+}
+
+// SetUserID sets the "user_id" field.
+func (iuc *InvitationUseCreate) SetUserID(u uint64) *InvitationUseCreate {
+	iuc.mutation.SetUserID(u)
+	return iuc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (iuc *InvitationUseCreate) SetUsedAt(t time.Time) *InvitationUseCreate {
+	iuc.mutation.SetUsedAt(t)
+	return iuc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (iuc *InvitationUseCreate) SetNillableUsedAt(t *time.Time) *InvitationUseCreate {
+	if t != nil {
+		iuc.SetUsedAt(*t)
+	}
+	return iuc
+}
+
+// SetID sets the "id" field.
+func (iuc *InvitationUseCreate) SetID(u uint64) *InvitationUseCreate {
+	iuc.mutation.SetID(u)
+	return iuc
+}
+
+// Mutation returns the InvitationUseMutation object of the builder.
+func (iuc *InvitationUseCreate) Mutation() *InvitationUseMutation {
+	return iuc.mutation
+}
+
+// Save creates the InvitationUse in the database.
+func (iuc *InvitationUseCreate) Save(ctx context.Context) (*InvitationUse, error) {
+	iuc.defaults()
+	return withHooks(ctx, iuc.sqlSave, iuc.mutation, iuc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iuc *InvitationUseCreate) SaveX(ctx context.Context) *InvitationUse {
+	v, err := iuc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iuc *InvitationUseCreate) Exec(ctx context.Context) error {
+	_, err := iuc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuc *InvitationUseCreate) ExecX(ctx context.Context) {
+	if err := iuc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuc *InvitationUseCreate) defaults() {
+	if _, ok := iuc.mutation.UsedAt(); !ok {
+		v := invitationuse.DefaultUsedAt()
+		iuc.mutation.SetUsedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuc *InvitationUseCreate) check() error {
+	if _, ok := iuc.mutation.InvitationID(); !ok {
+		return &ValidationError{Name: "invitation_id", err: errors.New(`gen: missing required field "InvitationUse.invitation_id"`)}
+	}
+	if _, ok := iuc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`gen: missing required field "InvitationUse.user_id"`)}
+	}
+	if _, ok := iuc.mutation.UsedAt(); !ok {
+		return &ValidationError{Name: "used_at", err: errors.New(`gen: missing required field "InvitationUse.used_at"`)}
+	}
+	return nil
+}
+
+func (iuc *InvitationUseCreate) sqlSave(ctx context.Context) (*InvitationUse, error) {
+	if err := iuc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iuc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iuc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	iuc.mutation.id = &_node.ID
+	iuc.mutation.done = true
+	return _node, nil
+}
+
+func (iuc *InvitationUseCreate) createSpec() (*InvitationUse, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvitationUse{config: iuc.config}
+		_spec = sqlgraph.NewCreateSpec(invitationuse.Table, sqlgraph.NewFieldSpec(invitationuse.FieldID, field.TypeUint64))
+	)
+	if id, ok := iuc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iuc.mutation.InvitationID(); ok {
+		_spec.SetField(invitationuse.FieldInvitationID, field.TypeUint64, value)
+		_node.InvitationID = value
+	}
+	if value, ok := iuc.mutation.UserID(); ok {
+		_spec.SetField(invitationuse.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := iuc.mutation.UsedAt(); ok {
+		_spec.SetField(invitationuse.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = value
+	}
+	return _node, _spec
+}
+
+// InvitationUseCreateBulk is the builder for creating many InvitationUse entities in bulk.
+type InvitationUseCreateBulk struct {
+	config
+	err      error
+	builders []*InvitationUseCreate
+}
+
+// Save creates the InvitationUse entities in the database.
+func (iucb *InvitationUseCreateBulk) Save(ctx context.Context) ([]*InvitationUse, error) {
+	if iucb.err != nil {
+		return nil, iucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iucb.builders))
+	nodes := make([]*InvitationUse, len(iucb.builders))
+	mutators := make([]Mutator, len(iucb.builders))
+	for i := range iucb.builders {
+		func(i int, root context.Context) {
+			builder := iucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationUseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iucb *InvitationUseCreateBulk) SaveX(ctx context.Context) []*InvitationUse {
+	v, err := iucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iucb *InvitationUseCreateBulk) Exec(ctx context.Context) error {
+	_, err := iucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iucb *InvitationUseCreateBulk) ExecX(ctx context.Context) {
+	if err := iucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// InvitationUseDelete is the builder for deleting a InvitationUse entity.
+type InvitationUseDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvitationUseMutation
+}
+
+// Where appends a list predicates to the InvitationUseDelete builder.
+func (iud *InvitationUseDelete) Where(ps ...predicate.InvitationUse) *InvitationUseDelete {
+	iud.mutation.Where(ps...)
+	return iud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iud *InvitationUseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iud.sqlExec, iud.mutation, iud.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iud *InvitationUseDelete) ExecX(ctx context.Context) int {
+	n, err := iud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iud *InvitationUseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invitationuse.Table, sqlgraph.NewFieldSpec(invitationuse.FieldID, field.TypeUint64))
+	if ps := iud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iud.mutation.done = true
+	return affected, err
+}
+
+// InvitationUseDeleteOne is the builder for deleting a single InvitationUse entity.
+type InvitationUseDeleteOne struct {
+	iud *InvitationUseDelete
+}
+
+// Where appends a list predicates to the InvitationUseDelete builder.
+func (iudo *InvitationUseDeleteOne) Where(ps ...predicate.InvitationUse) *InvitationUseDeleteOne {
+	iudo.iud.mutation.Where(ps...)
+	return iudo
+}
+
+// Exec executes the deletion query.
+func (iudo *InvitationUseDeleteOne) Exec(ctx context.Context) error {
+	n, err := iudo.iud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invitationuse.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iudo *InvitationUseDeleteOne) ExecX(ctx context.Context) {
+	if err := iudo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
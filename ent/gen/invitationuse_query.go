@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// InvitationUseQuery is the builder for querying InvitationUse entities.
+type InvitationUseQuery struct {
+	config
+	ctx        *QueryContext
+	order      []invitationuse.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InvitationUse
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvitationUseQuery builder.
+func (iuq *InvitationUseQuery) Where(ps ...predicate.InvitationUse) *InvitationUseQuery {
+	iuq.predicates = append(iuq.predicates, ps...)
+	return iuq
+}
+
+// Limit the number of records to be returned by this query.
+func (iuq *InvitationUseQuery) Limit(limit int) *InvitationUseQuery {
+	iuq.ctx.Limit = &limit
+	return iuq
+}
+
+// Offset to start from.
+func (iuq *InvitationUseQuery) Offset(offset int) *InvitationUseQuery {
+	iuq.ctx.Offset = &offset
+	return iuq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iuq *InvitationUseQuery) Unique(unique bool) *InvitationUseQuery {
+	iuq.ctx.Unique = &unique
+	return iuq
+}
+
+// Order specifies how the records should be ordered.
+func (iuq *InvitationUseQuery) Order(o ...invitationuse.OrderOption) *InvitationUseQuery {
+	iuq.order = append(iuq.order, o...)
+	return iuq
+}
+
+// First returns the first InvitationUse entity from the query.
+// Returns a *NotFoundError when no InvitationUse was found.
+func (iuq *InvitationUseQuery) First(ctx context.Context) (*InvitationUse, error) {
+	nodes, err := iuq.Limit(1).All(setContextOp(ctx, iuq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invitationuse.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iuq *InvitationUseQuery) FirstX(ctx context.Context) *InvitationUse {
+	node, err := iuq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvitationUse ID from the query.
+// Returns a *NotFoundError when no InvitationUse ID was found.
+func (iuq *InvitationUseQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = iuq.Limit(1).IDs(setContextOp(ctx, iuq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invitationuse.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iuq *InvitationUseQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := iuq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvitationUse entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvitationUse entity is found.
+// Returns a *NotFoundError when no InvitationUse entities are found.
+func (iuq *InvitationUseQuery) Only(ctx context.Context) (*InvitationUse, error) {
+	nodes, err := iuq.Limit(2).All(setContextOp(ctx, iuq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invitationuse.Label}
+	default:
+		return nil, &NotSingularError{invitationuse.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iuq *InvitationUseQuery) OnlyX(ctx context.Context) *InvitationUse {
+	node, err := iuq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvitationUse ID in the query.
+// Returns a *NotSingularError when more than one InvitationUse ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iuq *InvitationUseQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = iuq.Limit(2).IDs(setContextOp(ctx, iuq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invitationuse.Label}
+	default:
+		err = &NotSingularError{invitationuse.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iuq *InvitationUseQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := iuq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvitationUses.
+func (iuq *InvitationUseQuery) All(ctx context.Context) ([]*InvitationUse, error) {
+	ctx = setContextOp(ctx, iuq.ctx, ent.OpQueryAll)
+	if err := iuq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InvitationUse, *InvitationUseQuery]()
+	return withInterceptors[[]*InvitationUse](ctx, iuq, qr, iuq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iuq *InvitationUseQuery) AllX(ctx context.Context) []*InvitationUse {
+	nodes, err := iuq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvitationUse IDs.
+func (iuq *InvitationUseQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if iuq.ctx.Unique == nil && iuq.path != nil {
+		iuq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iuq.ctx, ent.OpQueryIDs)
+	if err = iuq.Select(invitationuse.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iuq *InvitationUseQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := iuq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iuq *InvitationUseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iuq.ctx, ent.OpQueryCount)
+	if err := iuq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iuq, querierCount[*InvitationUseQuery](), iuq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iuq *InvitationUseQuery) CountX(ctx context.Context) int {
+	count, err := iuq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iuq *InvitationUseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iuq.ctx, ent.OpQueryExist)
+	switch _, err := iuq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("gen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iuq *InvitationUseQuery) ExistX(ctx context.Context) bool {
+	exist, err := iuq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvitationUseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iuq *InvitationUseQuery) Clone() *InvitationUseQuery {
+	if iuq == nil {
+		return nil
+	}
+	return &InvitationUseQuery{
+		config:     iuq.config,
+		ctx:        iuq.ctx.Clone(),
+		order:      append([]invitationuse.OrderOption{}, iuq.order...),
+		inters:     append([]Interceptor{}, iuq.inters...),
+		predicates: append([]predicate.InvitationUse{}, iuq.predicates...),
+		// clone intermediate query.
+		sql:  iuq.sql.Clone(),
+		path: iuq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvitationID uint64 `json:"invitation_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvitationUse.Query().
+//		GroupBy(invitationuse.FieldInvitationID).
+//		Aggregate(gen.Count()).
+//		Scan(ctx, &v)
+func (iuq *InvitationUseQuery) GroupBy(field string, fields ...string) *InvitationUseGroupBy {
+	iuq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InvitationUseGroupBy{build: iuq}
+	grbuild.flds = &iuq.ctx.Fields
+	grbuild.label = invitationuse.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvitationID uint64 `json:"invitation_id,omitempty"`
+//	}
+//
+//	client.InvitationUse.Query().
+//		Select(invitationuse.FieldInvitationID).
+//		Scan(ctx, &v)
+func (iuq *InvitationUseQuery) Select(fields ...string) *InvitationUseSelect {
+	iuq.ctx.Fields = append(iuq.ctx.Fields, fields...)
+	sbuild := &InvitationUseSelect{InvitationUseQuery: iuq}
+	sbuild.label = invitationuse.Label
+	sbuild.flds, sbuild.scan = &iuq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InvitationUseSelect configured with the given aggregations.
+func (iuq *InvitationUseQuery) Aggregate(fns ...AggregateFunc) *InvitationUseSelect {
+	return iuq.Select().Aggregate(fns...)
+}
+
+func (iuq *InvitationUseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iuq.inters {
+		if inter == nil {
+			return fmt.Errorf("gen: uninitialized interceptor (forgotten import gen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iuq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iuq.ctx.Fields {
+		if !invitationuse.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+		}
+	}
+	if iuq.path != nil {
+		prev, err := iuq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iuq.sql = prev
+	}
+	return nil
+}
+
+func (iuq *InvitationUseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvitationUse, error) {
+	var (
+		nodes = []*InvitationUse{}
+		_spec = iuq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InvitationUse).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InvitationUse{config: iuq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iuq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iuq *InvitationUseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iuq.querySpec()
+	_spec.Node.Columns = iuq.ctx.Fields
+	if len(iuq.ctx.Fields) > 0 {
+		_spec.Unique = iuq.ctx.Unique != nil && *iuq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iuq.driver, _spec)
+}
+
+func (iuq *InvitationUseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(invitationuse.Table, invitationuse.Columns, sqlgraph.NewFieldSpec(invitationuse.FieldID, field.TypeUint64))
+	_spec.From = iuq.sql
+	if unique := iuq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iuq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iuq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitationuse.FieldID)
+		for i := range fields {
+			if fields[i] != invitationuse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iuq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iuq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iuq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iuq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iuq *InvitationUseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iuq.driver.Dialect())
+	t1 := builder.Table(invitationuse.Table)
+	columns := iuq.ctx.Fields
+	if len(columns) == 0 {
+		columns = invitationuse.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iuq.sql != nil {
+		selector = iuq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iuq.ctx.Unique != nil && *iuq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range iuq.predicates {
+		p(selector)
+	}
+	for _, p := range iuq.order {
+		p(selector)
+	}
+	if offset := iuq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iuq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InvitationUseGroupBy is the group-by builder for InvitationUse entities.
+type InvitationUseGroupBy struct {
+	selector
+	build *InvitationUseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iugb *InvitationUseGroupBy) Aggregate(fns ...AggregateFunc) *InvitationUseGroupBy {
+	iugb.fns = append(iugb.fns, fns...)
+	return iugb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iugb *InvitationUseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iugb.build.ctx, ent.OpQueryGroupBy)
+	if err := iugb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvitationUseQuery, *InvitationUseGroupBy](ctx, iugb.build, iugb, iugb.build.inters, v)
+}
+
+func (iugb *InvitationUseGroupBy) sqlScan(ctx context.Context, root *InvitationUseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iugb.fns))
+	for _, fn := range iugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iugb.flds)+len(iugb.fns))
+		for _, f := range *iugb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iugb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iugb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InvitationUseSelect is the builder for selecting fields of InvitationUse entities.
+type InvitationUseSelect struct {
+	*InvitationUseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ius *InvitationUseSelect) Aggregate(fns ...AggregateFunc) *InvitationUseSelect {
+	ius.fns = append(ius.fns, fns...)
+	return ius
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ius *InvitationUseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ius.ctx, ent.OpQuerySelect)
+	if err := ius.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvitationUseQuery, *InvitationUseSelect](ctx, ius.InvitationUseQuery, ius, ius.inters, v)
+}
+
+func (ius *InvitationUseSelect) sqlScan(ctx context.Context, root *InvitationUseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ius.fns))
+	for _, fn := range ius.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ius.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ius.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
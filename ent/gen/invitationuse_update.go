@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// InvitationUseUpdate is the builder for updating InvitationUse entities.
+type InvitationUseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationUseMutation
+}
+
+// Where appends a list predicates to the InvitationUseUpdate builder.
+func (iuu *InvitationUseUpdate) Where(ps ...predicate.InvitationUse) *InvitationUseUpdate {
+	iuu.mutation.Where(ps...)
+	return iuu
+}
+
+// SetInvitationID sets the "invitation_id" field.
+func (iuu *InvitationUseUpdate) SetInvitationID(u uint64) *InvitationUseUpdate {
+	iuu.mutation.ResetInvitationID()
+	iuu.mutation.SetInvitationID(u)
+	return iuu
+}
+
+// SetNillableInvitationID sets the "invitation_id" field if the given value is not nil.
+func (iuu *InvitationUseUpdate) SetNillableInvitationID(u *uint64) *InvitationUseUpdate {
+	if u != nil {
+		iuu.SetInvitationID(*u)
+	}
+	return iuu
+}
+
+// AddInvitationID adds u to the "invitation_id" field.
+func (iuu *InvitationUseUpdate) AddInvitationID(u int64) *InvitationUseUpdate {
+	iuu.mutation.AddInvitationID(u)
+	return iuu
+}
+
+// SetUserID sets the "user_id" field.
+func (iuu *InvitationUseUpdate) SetUserID(u uint64) *InvitationUseUpdate {
+	iuu.mutation.ResetUserID()
+	iuu.mutation.SetUserID(u)
+	return iuu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iuu *InvitationUseUpdate) SetNillableUserID(u *uint64) *InvitationUseUpdate {
+	if u != nil {
+		iuu.SetUserID(*u)
+	}
+	return iuu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (iuu *InvitationUseUpdate) AddUserID(u int64) *InvitationUseUpdate {
+	iuu.mutation.AddUserID(u)
+	return iuu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (iuu *InvitationUseUpdate) SetUsedAt(t time.Time) *InvitationUseUpdate {
+	iuu.mutation.SetUsedAt(t)
+	return iuu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (iuu *InvitationUseUpdate) SetNillableUsedAt(t *time.Time) *InvitationUseUpdate {
+	if t != nil {
+		iuu.SetUsedAt(*t)
+	}
+	return iuu
+}
+
+// Mutation returns the InvitationUseMutation object of the builder.
+func (iuu *InvitationUseUpdate) Mutation() *InvitationUseMutation {
+	return iuu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iuu *InvitationUseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iuu.sqlSave, iuu.mutation, iuu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuu *InvitationUseUpdate) SaveX(ctx context.Context) int {
+	affected, err := iuu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iuu *InvitationUseUpdate) Exec(ctx context.Context) error {
+	_, err := iuu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuu *InvitationUseUpdate) ExecX(ctx context.Context) {
+	if err := iuu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuu *InvitationUseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitationuse.Table, invitationuse.Columns, sqlgraph.NewFieldSpec(invitationuse.FieldID, field.TypeUint64))
+	if ps := iuu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuu.mutation.InvitationID(); ok {
+		_spec.SetField(invitationuse.FieldInvitationID, field.TypeUint64, value)
+	}
+	if value, ok := iuu.mutation.AddedInvitationID(); ok {
+		_spec.AddField(invitationuse.FieldInvitationID, field.TypeUint64, value)
+	}
+	if value, ok := iuu.mutation.UserID(); ok {
+		_spec.SetField(invitationuse.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := iuu.mutation.AddedUserID(); ok {
+		_spec.AddField(invitationuse.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := iuu.mutation.UsedAt(); ok {
+		_spec.SetField(invitationuse.FieldUsedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iuu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitationuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iuu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUseUpdateOne is the builder for updating a single InvitationUse entity.
+type InvitationUseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationUseMutation
+}
+
+// SetInvitationID sets the "invitation_id" field.
+func (iuuo *InvitationUseUpdateOne) SetInvitationID(u uint64) *InvitationUseUpdateOne {
+	iuuo.mutation.ResetInvitationID()
+	iuuo.mutation.SetInvitationID(u)
+	return iuuo
+}
+
+// SetNillableInvitationID sets the "invitation_id" field if the given value is not nil.
+func (iuuo *InvitationUseUpdateOne) SetNillableInvitationID(u *uint64) *InvitationUseUpdateOne {
+	if u != nil {
+		iuuo.SetInvitationID(*u)
+	}
+	return iuuo
+}
+
+// AddInvitationID adds u to the "invitation_id" field.
+func (iuuo *InvitationUseUpdateOne) AddInvitationID(u int64) *InvitationUseUpdateOne {
+	iuuo.mutation.AddInvitationID(u)
+	return iuuo
+}
+
+// SetUserID sets the "user_id" field.
+func (iuuo *InvitationUseUpdateOne) SetUserID(u uint64) *InvitationUseUpdateOne {
+	iuuo.mutation.ResetUserID()
+	iuuo.mutation.SetUserID(u)
+	return iuuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iuuo *InvitationUseUpdateOne) SetNillableUserID(u *uint64) *InvitationUseUpdateOne {
+	if u != nil {
+		iuuo.SetUserID(*u)
+	}
+	return iuuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (iuuo *InvitationUseUpdateOne) AddUserID(u int64) *InvitationUseUpdateOne {
+	iuuo.mutation.AddUserID(u)
+	return iuuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (iuuo *InvitationUseUpdateOne) SetUsedAt(t time.Time) *InvitationUseUpdateOne {
+	iuuo.mutation.SetUsedAt(t)
+	return iuuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (iuuo *InvitationUseUpdateOne) SetNillableUsedAt(t *time.Time) *InvitationUseUpdateOne {
+	if t != nil {
+		iuuo.SetUsedAt(*t)
+	}
+	return iuuo
+}
+
+// Mutation returns the InvitationUseMutation object of the builder.
+func (iuuo *InvitationUseUpdateOne) Mutation() *InvitationUseMutation {
+	return iuuo.mutation
+}
+
+// Where appends a list predicates to the InvitationUseUpdate builder.
+func (iuuo *InvitationUseUpdateOne) Where(ps ...predicate.InvitationUse) *InvitationUseUpdateOne {
+	iuuo.mutation.Where(ps...)
+	return iuuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuuo *InvitationUseUpdateOne) Select(field string, fields ...string) *InvitationUseUpdateOne {
+	iuuo.fields = append([]string{field}, fields...)
+	return iuuo
+}
+
+// Save executes the query and returns the updated InvitationUse entity.
+func (iuuo *InvitationUseUpdateOne) Save(ctx context.Context) (*InvitationUse, error) {
+	return withHooks(ctx, iuuo.sqlSave, iuuo.mutation, iuuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuuo *InvitationUseUpdateOne) SaveX(ctx context.Context) *InvitationUse {
+	node, err := iuuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuuo *InvitationUseUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuuo *InvitationUseUpdateOne) ExecX(ctx context.Context) {
+	if err := iuuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuuo *InvitationUseUpdateOne) sqlSave(ctx context.Context) (_node *InvitationUse, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitationuse.Table, invitationuse.Columns, sqlgraph.NewFieldSpec(invitationuse.FieldID, field.TypeUint64))
+	id, ok := iuuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "InvitationUse.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitationuse.FieldID)
+		for _, f := range fields {
+			if !invitationuse.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != invitationuse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuuo.mutation.InvitationID(); ok {
+		_spec.SetField(invitationuse.FieldInvitationID, field.TypeUint64, value)
+	}
+	if value, ok := iuuo.mutation.AddedInvitationID(); ok {
+		_spec.AddField(invitationuse.FieldInvitationID, field.TypeUint64, value)
+	}
+	if value, ok := iuuo.mutation.UserID(); ok {
+		_spec.SetField(invitationuse.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := iuuo.mutation.AddedUserID(); ok {
+		_spec.AddField(invitationuse.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := iuuo.mutation.UsedAt(); ok {
+		_spec.SetField(invitationuse.FieldUsedAt, field.TypeTime, value)
+	}
+	_node = &InvitationUse{config: iuuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitationuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/loginevent"
+)
+
+// LoginEvent is the model entity for the LoginEvent schema.
+type LoginEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint64 `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Device holds the value of the "device" field.
+	Device string `json:"device,omitempty"`
+	// NewDevice holds the value of the "new_device" field.
+	NewDevice bool `json:"new_device,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldNewDevice:
+			values[i] = new(sql.NullBool)
+		case loginevent.FieldID, loginevent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case loginevent.FieldType, loginevent.FieldMethod, loginevent.FieldReason, loginevent.FieldIPAddress, loginevent.FieldNetwork, loginevent.FieldUserAgent, loginevent.FieldDevice:
+			values[i] = new(sql.NullString)
+		case loginevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginEvent fields.
+func (le *LoginEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			le.ID = uint64(value.Int64)
+		case loginevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				le.UserID = new(uint64)
+				*le.UserID = uint64(value.Int64)
+			}
+		case loginevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				le.Type = value.String
+			}
+		case loginevent.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				le.Method = value.String
+			}
+		case loginevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				le.Reason = value.String
+			}
+		case loginevent.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				le.IPAddress = value.String
+			}
+		case loginevent.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				le.Network = value.String
+			}
+		case loginevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				le.UserAgent = value.String
+			}
+		case loginevent.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				le.Device = value.String
+			}
+		case loginevent.FieldNewDevice:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field new_device", values[i])
+			} else if value.Valid {
+				le.NewDevice = value.Bool
+			}
+		case loginevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Time
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginEvent.
+// This includes values selected through modifiers, order, etc.
+func (le *LoginEvent) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginEvent.
+// Note that you need to call LoginEvent.Unwrap() before calling this method if this LoginEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LoginEvent) Update() *LoginEventUpdateOne {
+	return NewLoginEventClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LoginEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LoginEvent) Unwrap() *LoginEvent {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: LoginEvent is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LoginEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	if v := le.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(le.Type)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(le.Method)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(le.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(le.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(le.Network)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(le.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(le.Device)
+	builder.WriteString(", ")
+	builder.WriteString("new_device=")
+	builder.WriteString(fmt.Sprintf("%v", le.NewDevice))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(le.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginEvents is a parsable slice of LoginEvent.
+type LoginEvents []*LoginEvent
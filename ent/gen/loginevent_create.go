@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/loginevent"
+)
+
+// LoginEventCreate is the builder for creating a LoginEvent entity.
+type LoginEventCreate struct {
+	config
+	mutation *LoginEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (lec *LoginEventCreate) SetUserID(u uint64) *LoginEventCreate {
+	lec.mutation.SetUserID(u)
+	return lec
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableUserID(u *uint64) *LoginEventCreate {
+	if u != nil {
+		lec.SetUserID(*u)
+	}
+	return lec
+}
+
+// SetType sets the "type" field.
+func (lec *LoginEventCreate) SetType(s string) *LoginEventCreate {
+	lec.mutation.SetType(s)
+	return lec
+}
+
+// SetMethod sets the "method" field.
+func (lec *LoginEventCreate) SetMethod(s string) *LoginEventCreate {
+	lec.mutation.SetMethod(s)
+	return lec
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableMethod(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetMethod(*s)
+	}
+	return lec
+}
+
+// SetReason sets the "reason" field.
+func (lec *LoginEventCreate) SetReason(s string) *LoginEventCreate {
+	lec.mutation.SetReason(s)
+	return lec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableReason(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetReason(*s)
+	}
+	return lec
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (lec *LoginEventCreate) SetIPAddress(s string) *LoginEventCreate {
+	lec.mutation.SetIPAddress(s)
+	return lec
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableIPAddress(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetIPAddress(*s)
+	}
+	return lec
+}
+
+// SetNetwork sets the "network" field.
+func (lec *LoginEventCreate) SetNetwork(s string) *LoginEventCreate {
+	lec.mutation.SetNetwork(s)
+	return lec
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableNetwork(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetNetwork(*s)
+	}
+	return lec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lec *LoginEventCreate) SetUserAgent(s string) *LoginEventCreate {
+	lec.mutation.SetUserAgent(s)
+	return lec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableUserAgent(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetUserAgent(*s)
+	}
+	return lec
+}
+
+// SetDevice sets the "device" field.
+func (lec *LoginEventCreate) SetDevice(s string) *LoginEventCreate {
+	lec.mutation.SetDevice(s)
+	return lec
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableDevice(s *string) *LoginEventCreate {
+	if s != nil {
+		lec.SetDevice(*s)
+	}
+	return lec
+}
+
+// SetNewDevice sets the "new_device" field.
+func (lec *LoginEventCreate) SetNewDevice(b bool) *LoginEventCreate {
+	lec.mutation.SetNewDevice(b)
+	return lec
+}
+
+// SetNillableNewDevice sets the "new_device" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableNewDevice(b *bool) *LoginEventCreate {
+	if b != nil {
+		lec.SetNewDevice(*b)
+	}
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LoginEventCreate) SetCreatedAt(t time.Time) *LoginEventCreate {
+	lec.mutation.SetCreatedAt(t)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LoginEventCreate) SetNillableCreatedAt(t *time.Time) *LoginEventCreate {
+	if t != nil {
+		lec.SetCreatedAt(*t)
+	}
+	return lec
+}
+
+// SetID sets the "id" field.
+func (lec *LoginEventCreate) SetID(u uint64) *LoginEventCreate {
+	lec.mutation.SetID(u)
+	return lec
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (lec *LoginEventCreate) Mutation() *LoginEventMutation {
+	return lec.mutation
+}
+
+// Save creates the LoginEvent in the database.
+func (lec *LoginEventCreate) Save(ctx context.Context) (*LoginEvent, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LoginEventCreate) SaveX(ctx context.Context) *LoginEvent {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LoginEventCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LoginEventCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LoginEventCreate) defaults() {
+	if _, ok := lec.mutation.NewDevice(); !ok {
+		v := loginevent.DefaultNewDevice
+		lec.mutation.SetNewDevice(v)
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := loginevent.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LoginEventCreate) check() error {
+	if _, ok := lec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`gen: missing required field "LoginEvent.type"`)}
+	}
+	if _, ok := lec.mutation.NewDevice(); !ok {
+		return &ValidationError{Name: "new_device", err: errors.New(`gen: missing required field "LoginEvent.new_device"`)}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "LoginEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (lec *LoginEventCreate) sqlSave(ctx context.Context) (*LoginEvent, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LoginEventCreate) createSpec() (*LoginEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginEvent{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(loginevent.Table, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	)
+	if id, ok := lec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lec.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+		_node.UserID = &value
+	}
+	if value, ok := lec.mutation.GetType(); ok {
+		_spec.SetField(loginevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := lec.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := lec.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lec.mutation.IPAddress(); ok {
+		_spec.SetField(loginevent.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := lec.mutation.Network(); ok {
+		_spec.SetField(loginevent.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := lec.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lec.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := lec.mutation.NewDevice(); ok {
+		_spec.SetField(loginevent.FieldNewDevice, field.TypeBool, value)
+		_node.NewDevice = value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginEventCreateBulk is the builder for creating many LoginEvent entities in bulk.
+type LoginEventCreateBulk struct {
+	config
+	err      error
+	builders []*LoginEventCreate
+}
+
+// Save creates the LoginEvent entities in the database.
+func (lecb *LoginEventCreateBulk) Save(ctx context.Context) ([]*LoginEvent, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LoginEvent, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LoginEventCreateBulk) SaveX(ctx context.Context) []*LoginEvent {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LoginEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LoginEventCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/loginevent"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// LoginEventUpdate is the builder for updating LoginEvent entities.
+type LoginEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (leu *LoginEventUpdate) Where(ps ...predicate.LoginEvent) *LoginEventUpdate {
+	leu.mutation.Where(ps...)
+	return leu
+}
+
+// SetUserID sets the "user_id" field.
+func (leu *LoginEventUpdate) SetUserID(u uint64) *LoginEventUpdate {
+	leu.mutation.ResetUserID()
+	leu.mutation.SetUserID(u)
+	return leu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableUserID(u *uint64) *LoginEventUpdate {
+	if u != nil {
+		leu.SetUserID(*u)
+	}
+	return leu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (leu *LoginEventUpdate) AddUserID(u int64) *LoginEventUpdate {
+	leu.mutation.AddUserID(u)
+	return leu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (leu *LoginEventUpdate) ClearUserID() *LoginEventUpdate {
+	leu.mutation.ClearUserID()
+	return leu
+}
+
+// SetType sets the "type" field.
+func (leu *LoginEventUpdate) SetType(s string) *LoginEventUpdate {
+	leu.mutation.SetType(s)
+	return leu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableType(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetType(*s)
+	}
+	return leu
+}
+
+// SetMethod sets the "method" field.
+func (leu *LoginEventUpdate) SetMethod(s string) *LoginEventUpdate {
+	leu.mutation.SetMethod(s)
+	return leu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableMethod(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetMethod(*s)
+	}
+	return leu
+}
+
+// ClearMethod clears the value of the "method" field.
+func (leu *LoginEventUpdate) ClearMethod() *LoginEventUpdate {
+	leu.mutation.ClearMethod()
+	return leu
+}
+
+// SetReason sets the "reason" field.
+func (leu *LoginEventUpdate) SetReason(s string) *LoginEventUpdate {
+	leu.mutation.SetReason(s)
+	return leu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableReason(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetReason(*s)
+	}
+	return leu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (leu *LoginEventUpdate) ClearReason() *LoginEventUpdate {
+	leu.mutation.ClearReason()
+	return leu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (leu *LoginEventUpdate) SetIPAddress(s string) *LoginEventUpdate {
+	leu.mutation.SetIPAddress(s)
+	return leu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableIPAddress(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetIPAddress(*s)
+	}
+	return leu
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (leu *LoginEventUpdate) ClearIPAddress() *LoginEventUpdate {
+	leu.mutation.ClearIPAddress()
+	return leu
+}
+
+// SetNetwork sets the "network" field.
+func (leu *LoginEventUpdate) SetNetwork(s string) *LoginEventUpdate {
+	leu.mutation.SetNetwork(s)
+	return leu
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableNetwork(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetNetwork(*s)
+	}
+	return leu
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (leu *LoginEventUpdate) ClearNetwork() *LoginEventUpdate {
+	leu.mutation.ClearNetwork()
+	return leu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (leu *LoginEventUpdate) SetUserAgent(s string) *LoginEventUpdate {
+	leu.mutation.SetUserAgent(s)
+	return leu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableUserAgent(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetUserAgent(*s)
+	}
+	return leu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (leu *LoginEventUpdate) ClearUserAgent() *LoginEventUpdate {
+	leu.mutation.ClearUserAgent()
+	return leu
+}
+
+// SetDevice sets the "device" field.
+func (leu *LoginEventUpdate) SetDevice(s string) *LoginEventUpdate {
+	leu.mutation.SetDevice(s)
+	return leu
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableDevice(s *string) *LoginEventUpdate {
+	if s != nil {
+		leu.SetDevice(*s)
+	}
+	return leu
+}
+
+// ClearDevice clears the value of the "device" field.
+func (leu *LoginEventUpdate) ClearDevice() *LoginEventUpdate {
+	leu.mutation.ClearDevice()
+	return leu
+}
+
+// SetNewDevice sets the "new_device" field.
+func (leu *LoginEventUpdate) SetNewDevice(b bool) *LoginEventUpdate {
+	leu.mutation.SetNewDevice(b)
+	return leu
+}
+
+// SetNillableNewDevice sets the "new_device" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableNewDevice(b *bool) *LoginEventUpdate {
+	if b != nil {
+		leu.SetNewDevice(*b)
+	}
+	return leu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (leu *LoginEventUpdate) SetCreatedAt(t time.Time) *LoginEventUpdate {
+	leu.mutation.SetCreatedAt(t)
+	return leu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (leu *LoginEventUpdate) SetNillableCreatedAt(t *time.Time) *LoginEventUpdate {
+	if t != nil {
+		leu.SetCreatedAt(*t)
+	}
+	return leu
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (leu *LoginEventUpdate) Mutation() *LoginEventMutation {
+	return leu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (leu *LoginEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, leu.sqlSave, leu.mutation, leu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leu *LoginEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := leu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (leu *LoginEventUpdate) Exec(ctx context.Context) error {
+	_, err := leu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leu *LoginEventUpdate) ExecX(ctx context.Context) {
+	if err := leu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (leu *LoginEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	if ps := leu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leu.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := leu.mutation.AddedUserID(); ok {
+		_spec.AddField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if leu.mutation.UserIDCleared() {
+		_spec.ClearField(loginevent.FieldUserID, field.TypeUint64)
+	}
+	if value, ok := leu.mutation.GetType(); ok {
+		_spec.SetField(loginevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+	}
+	if leu.mutation.MethodCleared() {
+		_spec.ClearField(loginevent.FieldMethod, field.TypeString)
+	}
+	if value, ok := leu.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if leu.mutation.ReasonCleared() {
+		_spec.ClearField(loginevent.FieldReason, field.TypeString)
+	}
+	if value, ok := leu.mutation.IPAddress(); ok {
+		_spec.SetField(loginevent.FieldIPAddress, field.TypeString, value)
+	}
+	if leu.mutation.IPAddressCleared() {
+		_spec.ClearField(loginevent.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := leu.mutation.Network(); ok {
+		_spec.SetField(loginevent.FieldNetwork, field.TypeString, value)
+	}
+	if leu.mutation.NetworkCleared() {
+		_spec.ClearField(loginevent.FieldNetwork, field.TypeString)
+	}
+	if value, ok := leu.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if leu.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := leu.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+	}
+	if leu.mutation.DeviceCleared() {
+		_spec.ClearField(loginevent.FieldDevice, field.TypeString)
+	}
+	if value, ok := leu.mutation.NewDevice(); ok {
+		_spec.SetField(loginevent.FieldNewDevice, field.TypeBool, value)
+	}
+	if value, ok := leu.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, leu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	leu.mutation.done = true
+	return n, nil
+}
+
+// LoginEventUpdateOne is the builder for updating a single LoginEvent entity.
+type LoginEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (leuo *LoginEventUpdateOne) SetUserID(u uint64) *LoginEventUpdateOne {
+	leuo.mutation.ResetUserID()
+	leuo.mutation.SetUserID(u)
+	return leuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableUserID(u *uint64) *LoginEventUpdateOne {
+	if u != nil {
+		leuo.SetUserID(*u)
+	}
+	return leuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (leuo *LoginEventUpdateOne) AddUserID(u int64) *LoginEventUpdateOne {
+	leuo.mutation.AddUserID(u)
+	return leuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (leuo *LoginEventUpdateOne) ClearUserID() *LoginEventUpdateOne {
+	leuo.mutation.ClearUserID()
+	return leuo
+}
+
+// SetType sets the "type" field.
+func (leuo *LoginEventUpdateOne) SetType(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetType(s)
+	return leuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableType(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetType(*s)
+	}
+	return leuo
+}
+
+// SetMethod sets the "method" field.
+func (leuo *LoginEventUpdateOne) SetMethod(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetMethod(s)
+	return leuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableMethod(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetMethod(*s)
+	}
+	return leuo
+}
+
+// ClearMethod clears the value of the "method" field.
+func (leuo *LoginEventUpdateOne) ClearMethod() *LoginEventUpdateOne {
+	leuo.mutation.ClearMethod()
+	return leuo
+}
+
+// SetReason sets the "reason" field.
+func (leuo *LoginEventUpdateOne) SetReason(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetReason(s)
+	return leuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableReason(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetReason(*s)
+	}
+	return leuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (leuo *LoginEventUpdateOne) ClearReason() *LoginEventUpdateOne {
+	leuo.mutation.ClearReason()
+	return leuo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (leuo *LoginEventUpdateOne) SetIPAddress(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetIPAddress(s)
+	return leuo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableIPAddress(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetIPAddress(*s)
+	}
+	return leuo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (leuo *LoginEventUpdateOne) ClearIPAddress() *LoginEventUpdateOne {
+	leuo.mutation.ClearIPAddress()
+	return leuo
+}
+
+// SetNetwork sets the "network" field.
+func (leuo *LoginEventUpdateOne) SetNetwork(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetNetwork(s)
+	return leuo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableNetwork(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetNetwork(*s)
+	}
+	return leuo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (leuo *LoginEventUpdateOne) ClearNetwork() *LoginEventUpdateOne {
+	leuo.mutation.ClearNetwork()
+	return leuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (leuo *LoginEventUpdateOne) SetUserAgent(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetUserAgent(s)
+	return leuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableUserAgent(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetUserAgent(*s)
+	}
+	return leuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (leuo *LoginEventUpdateOne) ClearUserAgent() *LoginEventUpdateOne {
+	leuo.mutation.ClearUserAgent()
+	return leuo
+}
+
+// SetDevice sets the "device" field.
+func (leuo *LoginEventUpdateOne) SetDevice(s string) *LoginEventUpdateOne {
+	leuo.mutation.SetDevice(s)
+	return leuo
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableDevice(s *string) *LoginEventUpdateOne {
+	if s != nil {
+		leuo.SetDevice(*s)
+	}
+	return leuo
+}
+
+// ClearDevice clears the value of the "device" field.
+func (leuo *LoginEventUpdateOne) ClearDevice() *LoginEventUpdateOne {
+	leuo.mutation.ClearDevice()
+	return leuo
+}
+
+// SetNewDevice sets the "new_device" field.
+func (leuo *LoginEventUpdateOne) SetNewDevice(b bool) *LoginEventUpdateOne {
+	leuo.mutation.SetNewDevice(b)
+	return leuo
+}
+
+// SetNillableNewDevice sets the "new_device" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableNewDevice(b *bool) *LoginEventUpdateOne {
+	if b != nil {
+		leuo.SetNewDevice(*b)
+	}
+	return leuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (leuo *LoginEventUpdateOne) SetCreatedAt(t time.Time) *LoginEventUpdateOne {
+	leuo.mutation.SetCreatedAt(t)
+	return leuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (leuo *LoginEventUpdateOne) SetNillableCreatedAt(t *time.Time) *LoginEventUpdateOne {
+	if t != nil {
+		leuo.SetCreatedAt(*t)
+	}
+	return leuo
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (leuo *LoginEventUpdateOne) Mutation() *LoginEventMutation {
+	return leuo.mutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (leuo *LoginEventUpdateOne) Where(ps ...predicate.LoginEvent) *LoginEventUpdateOne {
+	leuo.mutation.Where(ps...)
+	return leuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (leuo *LoginEventUpdateOne) Select(field string, fields ...string) *LoginEventUpdateOne {
+	leuo.fields = append([]string{field}, fields...)
+	return leuo
+}
+
+// Save executes the query and returns the updated LoginEvent entity.
+func (leuo *LoginEventUpdateOne) Save(ctx context.Context) (*LoginEvent, error) {
+	return withHooks(ctx, leuo.sqlSave, leuo.mutation, leuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leuo *LoginEventUpdateOne) SaveX(ctx context.Context) *LoginEvent {
+	node, err := leuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (leuo *LoginEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := leuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leuo *LoginEventUpdateOne) ExecX(ctx context.Context) {
+	if err := leuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (leuo *LoginEventUpdateOne) sqlSave(ctx context.Context) (_node *LoginEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	id, ok := leuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "LoginEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := leuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginevent.FieldID)
+		for _, f := range fields {
+			if !loginevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != loginevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := leuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leuo.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := leuo.mutation.AddedUserID(); ok {
+		_spec.AddField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if leuo.mutation.UserIDCleared() {
+		_spec.ClearField(loginevent.FieldUserID, field.TypeUint64)
+	}
+	if value, ok := leuo.mutation.GetType(); ok {
+		_spec.SetField(loginevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+	}
+	if leuo.mutation.MethodCleared() {
+		_spec.ClearField(loginevent.FieldMethod, field.TypeString)
+	}
+	if value, ok := leuo.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if leuo.mutation.ReasonCleared() {
+		_spec.ClearField(loginevent.FieldReason, field.TypeString)
+	}
+	if value, ok := leuo.mutation.IPAddress(); ok {
+		_spec.SetField(loginevent.FieldIPAddress, field.TypeString, value)
+	}
+	if leuo.mutation.IPAddressCleared() {
+		_spec.ClearField(loginevent.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := leuo.mutation.Network(); ok {
+		_spec.SetField(loginevent.FieldNetwork, field.TypeString, value)
+	}
+	if leuo.mutation.NetworkCleared() {
+		_spec.ClearField(loginevent.FieldNetwork, field.TypeString)
+	}
+	if value, ok := leuo.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if leuo.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := leuo.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+	}
+	if leuo.mutation.DeviceCleared() {
+		_spec.ClearField(loginevent.FieldDevice, field.TypeString)
+	}
+	if value, ok := leuo.mutation.NewDevice(); ok {
+		_spec.SetField(loginevent.FieldNewDevice, field.TypeBool, value)
+	}
+	if value, ok := leuo.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &LoginEvent{config: leuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, leuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	leuo.mutation.done = true
+	return _node, nil
+}
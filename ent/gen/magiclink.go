@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+)
+
+// MagicLink is the model entity for the MagicLink schema.
+type MagicLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// NonceHash holds the value of the "nonce_hash" field.
+	NonceHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MagicLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldID, magiclink.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case magiclink.FieldTokenHash, magiclink.FieldNonceHash:
+			values[i] = new(sql.NullString)
+		case magiclink.FieldExpiresAt, magiclink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MagicLink fields.
+func (ml *MagicLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ml.ID = uint64(value.Int64)
+		case magiclink.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ml.UserID = uint64(value.Int64)
+			}
+		case magiclink.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				ml.TokenHash = value.String
+			}
+		case magiclink.FieldNonceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce_hash", values[i])
+			} else if value.Valid {
+				ml.NonceHash = value.String
+			}
+		case magiclink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ml.ExpiresAt = value.Time
+			}
+		case magiclink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ml.CreatedAt = value.Time
+			}
+		default:
+			ml.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MagicLink.
+// This includes values selected through modifiers, order, etc.
+func (ml *MagicLink) Value(name string) (ent.Value, error) {
+	return ml.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MagicLink.
+// Note that you need to call MagicLink.Unwrap() before calling this method if this MagicLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ml *MagicLink) Update() *MagicLinkUpdateOne {
+	return NewMagicLinkClient(ml.config).UpdateOne(ml)
+}
+
+// Unwrap unwraps the MagicLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ml *MagicLink) Unwrap() *MagicLink {
+	_tx, ok := ml.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: MagicLink is not a transactional entity")
+	}
+	ml.config.driver = _tx.drv
+	return ml
+}
+
+// String implements the fmt.Stringer.
+func (ml *MagicLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("MagicLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ml.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ml.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("nonce_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ml.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ml.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MagicLinks is a parsable slice of MagicLink.
+type MagicLinks []*MagicLink
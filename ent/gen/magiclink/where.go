@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package magiclink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUserID, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// NonceHash applies equality check predicate on the "nonce_hash" field. It's identical to NonceHashEQ.
+func NonceHash(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldNonceHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldUserID, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// NonceHashEQ applies the EQ predicate on the "nonce_hash" field.
+func NonceHashEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldNonceHash, v))
+}
+
+// NonceHashNEQ applies the NEQ predicate on the "nonce_hash" field.
+func NonceHashNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldNonceHash, v))
+}
+
+// NonceHashIn applies the In predicate on the "nonce_hash" field.
+func NonceHashIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldNonceHash, vs...))
+}
+
+// NonceHashNotIn applies the NotIn predicate on the "nonce_hash" field.
+func NonceHashNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldNonceHash, vs...))
+}
+
+// NonceHashGT applies the GT predicate on the "nonce_hash" field.
+func NonceHashGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldNonceHash, v))
+}
+
+// NonceHashGTE applies the GTE predicate on the "nonce_hash" field.
+func NonceHashGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldNonceHash, v))
+}
+
+// NonceHashLT applies the LT predicate on the "nonce_hash" field.
+func NonceHashLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldNonceHash, v))
+}
+
+// NonceHashLTE applies the LTE predicate on the "nonce_hash" field.
+func NonceHashLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldNonceHash, v))
+}
+
+// NonceHashContains applies the Contains predicate on the "nonce_hash" field.
+func NonceHashContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldNonceHash, v))
+}
+
+// NonceHashHasPrefix applies the HasPrefix predicate on the "nonce_hash" field.
+func NonceHashHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldNonceHash, v))
+}
+
+// NonceHashHasSuffix applies the HasSuffix predicate on the "nonce_hash" field.
+func NonceHashHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldNonceHash, v))
+}
+
+// NonceHashEqualFold applies the EqualFold predicate on the "nonce_hash" field.
+func NonceHashEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldNonceHash, v))
+}
+
+// NonceHashContainsFold applies the ContainsFold predicate on the "nonce_hash" field.
+func NonceHashContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldNonceHash, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+)
+
+// MagicLinkCreate is the builder for creating a MagicLink entity.
+type MagicLinkCreate struct {
+	config
+	mutation *MagicLinkMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (mlc *MagicLinkCreate) SetUserID(u uint64) *MagicLinkCreate {
+	mlc.mutation.SetUserID(u)
+	return mlc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (mlc *MagicLinkCreate) SetTokenHash(s string) *MagicLinkCreate {
+	mlc.mutation.SetTokenHash(s)
+	return mlc
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (mlc *MagicLinkCreate) SetNonceHash(s string) *MagicLinkCreate {
+	mlc.mutation.SetNonceHash(s)
+	return mlc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mlc *MagicLinkCreate) SetExpiresAt(t time.Time) *MagicLinkCreate {
+	mlc.mutation.SetExpiresAt(t)
+	return mlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mlc *MagicLinkCreate) SetCreatedAt(t time.Time) *MagicLinkCreate {
+	mlc.mutation.SetCreatedAt(t)
+	return mlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableCreatedAt(t *time.Time) *MagicLinkCreate {
+	if t != nil {
+		mlc.SetCreatedAt(*t)
+	}
+	return mlc
+}
+
+// SetID sets the "id" field.
+func (mlc *MagicLinkCreate) SetID(u uint64) *MagicLinkCreate {
+	mlc.mutation.SetID(u)
+	return mlc
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlc *MagicLinkCreate) Mutation() *MagicLinkMutation {
+	return mlc.mutation
+}
+
+// Save creates the MagicLink in the database.
+func (mlc *MagicLinkCreate) Save(ctx context.Context) (*MagicLink, error) {
+	mlc.defaults()
+	return withHooks(ctx, mlc.sqlSave, mlc.mutation, mlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mlc *MagicLinkCreate) SaveX(ctx context.Context) *MagicLink {
+	v, err := mlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlc *MagicLinkCreate) Exec(ctx context.Context) error {
+	_, err := mlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlc *MagicLinkCreate) ExecX(ctx context.Context) {
+	if err := mlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mlc *MagicLinkCreate) defaults() {
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		v := magiclink.DefaultCreatedAt()
+		mlc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mlc *MagicLinkCreate) check() error {
+	if _, ok := mlc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`gen: missing required field "MagicLink.user_id"`)}
+	}
+	if _, ok := mlc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`gen: missing required field "MagicLink.token_hash"`)}
+	}
+	if _, ok := mlc.mutation.NonceHash(); !ok {
+		return &ValidationError{Name: "nonce_hash", err: errors.New(`gen: missing required field "MagicLink.nonce_hash"`)}
+	}
+	if _, ok := mlc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`gen: missing required field "MagicLink.expires_at"`)}
+	}
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "MagicLink.created_at"`)}
+	}
+	return nil
+}
+
+func (mlc *MagicLinkCreate) sqlSave(ctx context.Context) (*MagicLink, error) {
+	if err := mlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	mlc.mutation.id = &_node.ID
+	mlc.mutation.done = true
+	return _node, nil
+}
+
+func (mlc *MagicLinkCreate) createSpec() (*MagicLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MagicLink{config: mlc.config}
+		_spec = sqlgraph.NewCreateSpec(magiclink.Table, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUint64))
+	)
+	if id, ok := mlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mlc.mutation.UserID(); ok {
+		_spec.SetField(magiclink.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := mlc.mutation.TokenHash(); ok {
+		_spec.SetField(magiclink.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := mlc.mutation.NonceHash(); ok {
+		_spec.SetField(magiclink.FieldNonceHash, field.TypeString, value)
+		_node.NonceHash = value
+	}
+	if value, ok := mlc.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := mlc.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// MagicLinkCreateBulk is the builder for creating many MagicLink entities in bulk.
+type MagicLinkCreateBulk struct {
+	config
+	err      error
+	builders []*MagicLinkCreate
+}
+
+// Save creates the MagicLink entities in the database.
+func (mlcb *MagicLinkCreateBulk) Save(ctx context.Context) ([]*MagicLink, error) {
+	if mlcb.err != nil {
+		return nil, mlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mlcb.builders))
+	nodes := make([]*MagicLink, len(mlcb.builders))
+	mutators := make([]Mutator, len(mlcb.builders))
+	for i := range mlcb.builders {
+		func(i int, root context.Context) {
+			builder := mlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MagicLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) SaveX(ctx context.Context) []*MagicLink {
+	v, err := mlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlcb *MagicLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := mlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := mlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
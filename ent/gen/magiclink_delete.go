@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// MagicLinkDelete is the builder for deleting a MagicLink entity.
+type MagicLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// Where appends a list predicates to the MagicLinkDelete builder.
+func (mld *MagicLinkDelete) Where(ps ...predicate.MagicLink) *MagicLinkDelete {
+	mld.mutation.Where(ps...)
+	return mld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mld *MagicLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mld.sqlExec, mld.mutation, mld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mld *MagicLinkDelete) ExecX(ctx context.Context) int {
+	n, err := mld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mld *MagicLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(magiclink.Table, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUint64))
+	if ps := mld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mld.mutation.done = true
+	return affected, err
+}
+
+// MagicLinkDeleteOne is the builder for deleting a single MagicLink entity.
+type MagicLinkDeleteOne struct {
+	mld *MagicLinkDelete
+}
+
+// Where appends a list predicates to the MagicLinkDelete builder.
+func (mldo *MagicLinkDeleteOne) Where(ps ...predicate.MagicLink) *MagicLinkDeleteOne {
+	mldo.mld.mutation.Where(ps...)
+	return mldo
+}
+
+// Exec executes the deletion query.
+func (mldo *MagicLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := mldo.mld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{magiclink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mldo *MagicLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := mldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
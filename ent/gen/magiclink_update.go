@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// MagicLinkUpdate is the builder for updating MagicLink entities.
+type MagicLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mlu *MagicLinkUpdate) Where(ps ...predicate.MagicLink) *MagicLinkUpdate {
+	mlu.mutation.Where(ps...)
+	return mlu
+}
+
+// SetUserID sets the "user_id" field.
+func (mlu *MagicLinkUpdate) SetUserID(u uint64) *MagicLinkUpdate {
+	mlu.mutation.ResetUserID()
+	mlu.mutation.SetUserID(u)
+	return mlu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableUserID(u *uint64) *MagicLinkUpdate {
+	if u != nil {
+		mlu.SetUserID(*u)
+	}
+	return mlu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (mlu *MagicLinkUpdate) AddUserID(u int64) *MagicLinkUpdate {
+	mlu.mutation.AddUserID(u)
+	return mlu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (mlu *MagicLinkUpdate) SetTokenHash(s string) *MagicLinkUpdate {
+	mlu.mutation.SetTokenHash(s)
+	return mlu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableTokenHash(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetTokenHash(*s)
+	}
+	return mlu
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (mlu *MagicLinkUpdate) SetNonceHash(s string) *MagicLinkUpdate {
+	mlu.mutation.SetNonceHash(s)
+	return mlu
+}
+
+// SetNillableNonceHash sets the "nonce_hash" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableNonceHash(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetNonceHash(*s)
+	}
+	return mlu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mlu *MagicLinkUpdate) SetExpiresAt(t time.Time) *MagicLinkUpdate {
+	mlu.mutation.SetExpiresAt(t)
+	return mlu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableExpiresAt(t *time.Time) *MagicLinkUpdate {
+	if t != nil {
+		mlu.SetExpiresAt(*t)
+	}
+	return mlu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mlu *MagicLinkUpdate) SetCreatedAt(t time.Time) *MagicLinkUpdate {
+	mlu.mutation.SetCreatedAt(t)
+	return mlu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableCreatedAt(t *time.Time) *MagicLinkUpdate {
+	if t != nil {
+		mlu.SetCreatedAt(*t)
+	}
+	return mlu
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlu *MagicLinkUpdate) Mutation() *MagicLinkMutation {
+	return mlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mlu *MagicLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mlu.sqlSave, mlu.mutation, mlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := mlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mlu *MagicLinkUpdate) Exec(ctx context.Context) error {
+	_, err := mlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) ExecX(ctx context.Context) {
+	if err := mlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mlu *MagicLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUint64))
+	if ps := mlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mlu.mutation.UserID(); ok {
+		_spec.SetField(magiclink.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mlu.mutation.AddedUserID(); ok {
+		_spec.AddField(magiclink.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mlu.mutation.TokenHash(); ok {
+		_spec.SetField(magiclink.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.NonceHash(); ok {
+		_spec.SetField(magiclink.FieldNonceHash, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := mlu.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mlu.mutation.done = true
+	return n, nil
+}
+
+// MagicLinkUpdateOne is the builder for updating a single MagicLink entity.
+type MagicLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (mluo *MagicLinkUpdateOne) SetUserID(u uint64) *MagicLinkUpdateOne {
+	mluo.mutation.ResetUserID()
+	mluo.mutation.SetUserID(u)
+	return mluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableUserID(u *uint64) *MagicLinkUpdateOne {
+	if u != nil {
+		mluo.SetUserID(*u)
+	}
+	return mluo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (mluo *MagicLinkUpdateOne) AddUserID(u int64) *MagicLinkUpdateOne {
+	mluo.mutation.AddUserID(u)
+	return mluo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (mluo *MagicLinkUpdateOne) SetTokenHash(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetTokenHash(s)
+	return mluo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableTokenHash(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetTokenHash(*s)
+	}
+	return mluo
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (mluo *MagicLinkUpdateOne) SetNonceHash(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetNonceHash(s)
+	return mluo
+}
+
+// SetNillableNonceHash sets the "nonce_hash" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableNonceHash(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetNonceHash(*s)
+	}
+	return mluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mluo *MagicLinkUpdateOne) SetExpiresAt(t time.Time) *MagicLinkUpdateOne {
+	mluo.mutation.SetExpiresAt(t)
+	return mluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableExpiresAt(t *time.Time) *MagicLinkUpdateOne {
+	if t != nil {
+		mluo.SetExpiresAt(*t)
+	}
+	return mluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mluo *MagicLinkUpdateOne) SetCreatedAt(t time.Time) *MagicLinkUpdateOne {
+	mluo.mutation.SetCreatedAt(t)
+	return mluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableCreatedAt(t *time.Time) *MagicLinkUpdateOne {
+	if t != nil {
+		mluo.SetCreatedAt(*t)
+	}
+	return mluo
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mluo *MagicLinkUpdateOne) Mutation() *MagicLinkMutation {
+	return mluo.mutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mluo *MagicLinkUpdateOne) Where(ps ...predicate.MagicLink) *MagicLinkUpdateOne {
+	mluo.mutation.Where(ps...)
+	return mluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mluo *MagicLinkUpdateOne) Select(field string, fields ...string) *MagicLinkUpdateOne {
+	mluo.fields = append([]string{field}, fields...)
+	return mluo
+}
+
+// Save executes the query and returns the updated MagicLink entity.
+func (mluo *MagicLinkUpdateOne) Save(ctx context.Context) (*MagicLink, error) {
+	return withHooks(ctx, mluo.sqlSave, mluo.mutation, mluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) SaveX(ctx context.Context) *MagicLink {
+	node, err := mluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mluo *MagicLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := mluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := mluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mluo *MagicLinkUpdateOne) sqlSave(ctx context.Context) (_node *MagicLink, err error) {
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUint64))
+	id, ok := mluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "MagicLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclink.FieldID)
+		for _, f := range fields {
+			if !magiclink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != magiclink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mluo.mutation.UserID(); ok {
+		_spec.SetField(magiclink.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mluo.mutation.AddedUserID(); ok {
+		_spec.AddField(magiclink.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mluo.mutation.TokenHash(); ok {
+		_spec.SetField(magiclink.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.NonceHash(); ok {
+		_spec.SetField(magiclink.FieldNonceHash, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := mluo.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &MagicLink{config: mluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mluo.mutation.done = true
+	return _node, nil
+}
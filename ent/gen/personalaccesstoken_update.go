@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gmhafiz/go8/ent/gen/personalaccesstoken"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+// PersonalAccessTokenUpdate is the builder for updating PersonalAccessToken entities.
+type PersonalAccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (patu *PersonalAccessTokenUpdate) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdate {
+	patu.mutation.Where(ps...)
+	return patu
+}
+
+// SetUserID sets the "user_id" field.
+func (patu *PersonalAccessTokenUpdate) SetUserID(u uint64) *PersonalAccessTokenUpdate {
+	patu.mutation.ResetUserID()
+	patu.mutation.SetUserID(u)
+	return patu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableUserID(u *uint64) *PersonalAccessTokenUpdate {
+	if u != nil {
+		patu.SetUserID(*u)
+	}
+	return patu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (patu *PersonalAccessTokenUpdate) AddUserID(u int64) *PersonalAccessTokenUpdate {
+	patu.mutation.AddUserID(u)
+	return patu
+}
+
+// SetName sets the "name" field.
+func (patu *PersonalAccessTokenUpdate) SetName(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetName(s)
+	return patu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableName(s *string) *PersonalAccessTokenUpdate {
+	if s != nil {
+		patu.SetName(*s)
+	}
+	return patu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patu *PersonalAccessTokenUpdate) SetTokenHash(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetTokenHash(s)
+	return patu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableTokenHash(s *string) *PersonalAccessTokenUpdate {
+	if s != nil {
+		patu.SetTokenHash(*s)
+	}
+	return patu
+}
+
+// SetScopes sets the "scopes" field.
+func (patu *PersonalAccessTokenUpdate) SetScopes(s []string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetScopes(s)
+	return patu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (patu *PersonalAccessTokenUpdate) AppendScopes(s []string) *PersonalAccessTokenUpdate {
+	patu.mutation.AppendScopes(s)
+	return patu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patu *PersonalAccessTokenUpdate) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetExpiresAt(t)
+	return patu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetExpiresAt(*t)
+	}
+	return patu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearExpiresAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearExpiresAt()
+	return patu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetLastUsedAt(t)
+	return patu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetLastUsedAt(*t)
+	}
+	return patu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearLastUsedAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearLastUsedAt()
+	return patu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (patu *PersonalAccessTokenUpdate) SetCreatedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetCreatedAt(t)
+	return patu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableCreatedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetCreatedAt(*t)
+	}
+	return patu
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patu *PersonalAccessTokenUpdate) Mutation() *PersonalAccessTokenMutation {
+	return patu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (patu *PersonalAccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, patu.sqlSave, patu.mutation, patu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := patu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (patu *PersonalAccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := patu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := patu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (patu *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeUint64))
+	if ps := patu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patu.mutation.UserID(); ok {
+		_spec.SetField(personalaccesstoken.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := patu.mutation.AddedUserID(); ok {
+		_spec.AddField(personalaccesstoken.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := patu.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := patu.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := patu.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := patu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := patu.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if patu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := patu.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if patu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := patu.mutation.CreatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, patu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	patu.mutation.done = true
+	return n, nil
+}
+
+// PersonalAccessTokenUpdateOne is the builder for updating a single PersonalAccessToken entity.
+type PersonalAccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetUserID(u uint64) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ResetUserID()
+	patuo.mutation.SetUserID(u)
+	return patuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableUserID(u *uint64) *PersonalAccessTokenUpdateOne {
+	if u != nil {
+		patuo.SetUserID(*u)
+	}
+	return patuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (patuo *PersonalAccessTokenUpdateOne) AddUserID(u int64) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.AddUserID(u)
+	return patuo
+}
+
+// SetName sets the "name" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetName(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetName(s)
+	return patuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableName(s *string) *PersonalAccessTokenUpdateOne {
+	if s != nil {
+		patuo.SetName(*s)
+	}
+	return patuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetTokenHash(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetTokenHash(s)
+	return patuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableTokenHash(s *string) *PersonalAccessTokenUpdateOne {
+	if s != nil {
+		patuo.SetTokenHash(*s)
+	}
+	return patuo
+}
+
+// SetScopes sets the "scopes" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetScopes(s []string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetScopes(s)
+	return patuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (patuo *PersonalAccessTokenUpdateOne) AppendScopes(s []string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.AppendScopes(s)
+	return patuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetExpiresAt(t)
+	return patuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetExpiresAt(*t)
+	}
+	return patuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearExpiresAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearExpiresAt()
+	return patuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetLastUsedAt(t)
+	return patuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetLastUsedAt(*t)
+	}
+	return patuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearLastUsedAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearLastUsedAt()
+	return patuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetCreatedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetCreatedAt(t)
+	return patuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetCreatedAt(*t)
+	}
+	return patuo
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patuo *PersonalAccessTokenUpdateOne) Mutation() *PersonalAccessTokenMutation {
+	return patuo.mutation
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (patuo *PersonalAccessTokenUpdateOne) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.Where(ps...)
+	return patuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (patuo *PersonalAccessTokenUpdateOne) Select(field string, fields ...string) *PersonalAccessTokenUpdateOne {
+	patuo.fields = append([]string{field}, fields...)
+	return patuo
+}
+
+// Save executes the query and returns the updated PersonalAccessToken entity.
+func (patuo *PersonalAccessTokenUpdateOne) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	return withHooks(ctx, patuo.sqlSave, patuo.mutation, patuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) SaveX(ctx context.Context) *PersonalAccessToken {
+	node, err := patuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (patuo *PersonalAccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := patuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := patuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (patuo *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *PersonalAccessToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeUint64))
+	id, ok := patuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "PersonalAccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := patuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personalaccesstoken.FieldID)
+		for _, f := range fields {
+			if !personalaccesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != personalaccesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := patuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patuo.mutation.UserID(); ok {
+		_spec.SetField(personalaccesstoken.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := patuo.mutation.AddedUserID(); ok {
+		_spec.AddField(personalaccesstoken.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := patuo.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := patuo.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := patuo.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := patuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := patuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if patuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := patuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if patuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := patuo.mutation.CreatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &PersonalAccessToken{config: patuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, patuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	patuo.mutation.done = true
+	return _node, nil
+}
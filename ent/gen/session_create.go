@@ -20,6 +20,20 @@ type SessionCreate struct {
 	hooks    []Hook
 }
 
+// SetPublicID sets the "public_id" field.
+func (sc *SessionCreate) SetPublicID(s string) *SessionCreate {
+	sc.mutation.SetPublicID(s)
+	return sc
+}
+
+// SetNillablePublicID sets the "public_id" field if the given value is not nil.
+func (sc *SessionCreate) SetNillablePublicID(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetPublicID(*s)
+	}
+	return sc
+}
+
 // SetUserID sets the "user_id" field.
 func (sc *SessionCreate) SetUserID(u uint64) *SessionCreate {
 	sc.mutation.SetUserID(u)
@@ -46,6 +60,76 @@ func (sc *SessionCreate) SetExpiry(t time.Time) *SessionCreate {
 	return sc
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (sc *SessionCreate) SetCreatedAt(t time.Time) *SessionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableCreatedAt(t *time.Time) *SessionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (sc *SessionCreate) SetLastSeenAt(t time.Time) *SessionCreate {
+	sc.mutation.SetLastSeenAt(t)
+	return sc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableLastSeenAt(t *time.Time) *SessionCreate {
+	if t != nil {
+		sc.SetLastSeenAt(*t)
+	}
+	return sc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (sc *SessionCreate) SetIPAddress(s string) *SessionCreate {
+	sc.mutation.SetIPAddress(s)
+	return sc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableIPAddress(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetIPAddress(*s)
+	}
+	return sc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sc *SessionCreate) SetUserAgent(s string) *SessionCreate {
+	sc.mutation.SetUserAgent(s)
+	return sc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableUserAgent(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetUserAgent(*s)
+	}
+	return sc
+}
+
+// SetDevice sets the "device" field.
+func (sc *SessionCreate) SetDevice(s string) *SessionCreate {
+	sc.mutation.SetDevice(s)
+	return sc
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (sc *SessionCreate) SetNillableDevice(s *string) *SessionCreate {
+	if s != nil {
+		sc.SetDevice(*s)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SessionCreate) SetID(s string) *SessionCreate {
 	sc.mutation.SetID(s)
@@ -59,6 +143,7 @@ func (sc *SessionCreate) Mutation() *SessionMutation {
 
 // Save creates the Session in the database.
 func (sc *SessionCreate) Save(ctx context.Context) (*Session, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -84,6 +169,18 @@ func (sc *SessionCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SessionCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := session.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.LastSeenAt(); !ok {
+		v := session.DefaultLastSeenAt()
+		sc.mutation.SetLastSeenAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SessionCreate) check() error {
 	if _, ok := sc.mutation.Data(); !ok {
@@ -92,6 +189,12 @@ func (sc *SessionCreate) check() error {
 	if _, ok := sc.mutation.Expiry(); !ok {
 		return &ValidationError{Name: "expiry", err: errors.New(`gen: missing required field "Session.expiry"`)}
 	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "Session.created_at"`)}
+	}
+	if _, ok := sc.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`gen: missing required field "Session.last_seen_at"`)}
+	}
 	return nil
 }
 
@@ -127,6 +230,10 @@ func (sc *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := sc.mutation.PublicID(); ok {
+		_spec.SetField(session.FieldPublicID, field.TypeString, value)
+		_node.PublicID = value
+	}
 	if value, ok := sc.mutation.UserID(); ok {
 		_spec.SetField(session.FieldUserID, field.TypeUint64, value)
 		_node.UserID = &value
@@ -139,6 +246,26 @@ func (sc *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_spec.SetField(session.FieldExpiry, field.TypeTime, value)
 		_node.Expiry = value
 	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := sc.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := sc.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := sc.mutation.Device(); ok {
+		_spec.SetField(session.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
 	return _node, _spec
 }
 
@@ -160,6 +287,7 @@ func (scb *SessionCreateBulk) Save(ctx context.Context) ([]*Session, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SessionMutation)
 				if !ok {
@@ -262,12 +262,12 @@ func (sq *SessionQuery) Clone() *SessionQuery {
 // Example:
 //
 //	var v []struct {
-//		UserID uint64 `json:"user_id,omitempty"`
+//		PublicID string `json:"public_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Session.Query().
-//		GroupBy(session.FieldUserID).
+//		GroupBy(session.FieldPublicID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (sq *SessionQuery) GroupBy(field string, fields ...string) *SessionGroupBy {
@@ -285,11 +285,11 @@ func (sq *SessionQuery) GroupBy(field string, fields ...string) *SessionGroupBy
 // Example:
 //
 //	var v []struct {
-//		UserID uint64 `json:"user_id,omitempty"`
+//		PublicID string `json:"public_id,omitempty"`
 //	}
 //
 //	client.Session.Query().
-//		Select(session.FieldUserID).
+//		Select(session.FieldPublicID).
 //		Scan(ctx, &v)
 func (sq *SessionQuery) Select(fields ...string) *SessionSelect {
 	sq.ctx.Fields = append(sq.ctx.Fields, fields...)
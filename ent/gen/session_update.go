@@ -28,6 +28,26 @@ func (su *SessionUpdate) Where(ps ...predicate.Session) *SessionUpdate {
 	return su
 }
 
+// SetPublicID sets the "public_id" field.
+func (su *SessionUpdate) SetPublicID(s string) *SessionUpdate {
+	su.mutation.SetPublicID(s)
+	return su
+}
+
+// SetNillablePublicID sets the "public_id" field if the given value is not nil.
+func (su *SessionUpdate) SetNillablePublicID(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetPublicID(*s)
+	}
+	return su
+}
+
+// ClearPublicID clears the value of the "public_id" field.
+func (su *SessionUpdate) ClearPublicID() *SessionUpdate {
+	su.mutation.ClearPublicID()
+	return su
+}
+
 // SetUserID sets the "user_id" field.
 func (su *SessionUpdate) SetUserID(u uint64) *SessionUpdate {
 	su.mutation.ResetUserID()
@@ -75,6 +95,94 @@ func (su *SessionUpdate) SetNillableExpiry(t *time.Time) *SessionUpdate {
 	return su
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (su *SessionUpdate) SetCreatedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableCreatedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (su *SessionUpdate) SetLastSeenAt(t time.Time) *SessionUpdate {
+	su.mutation.SetLastSeenAt(t)
+	return su
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableLastSeenAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetLastSeenAt(*t)
+	}
+	return su
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (su *SessionUpdate) SetIPAddress(s string) *SessionUpdate {
+	su.mutation.SetIPAddress(s)
+	return su
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableIPAddress(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetIPAddress(*s)
+	}
+	return su
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (su *SessionUpdate) ClearIPAddress() *SessionUpdate {
+	su.mutation.ClearIPAddress()
+	return su
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (su *SessionUpdate) SetUserAgent(s string) *SessionUpdate {
+	su.mutation.SetUserAgent(s)
+	return su
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableUserAgent(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetUserAgent(*s)
+	}
+	return su
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (su *SessionUpdate) ClearUserAgent() *SessionUpdate {
+	su.mutation.ClearUserAgent()
+	return su
+}
+
+// SetDevice sets the "device" field.
+func (su *SessionUpdate) SetDevice(s string) *SessionUpdate {
+	su.mutation.SetDevice(s)
+	return su
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableDevice(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetDevice(*s)
+	}
+	return su
+}
+
+// ClearDevice clears the value of the "device" field.
+func (su *SessionUpdate) ClearDevice() *SessionUpdate {
+	su.mutation.ClearDevice()
+	return su
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (su *SessionUpdate) Mutation() *SessionMutation {
 	return su.mutation
@@ -116,6 +224,12 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.PublicID(); ok {
+		_spec.SetField(session.FieldPublicID, field.TypeString, value)
+	}
+	if su.mutation.PublicIDCleared() {
+		_spec.ClearField(session.FieldPublicID, field.TypeString)
+	}
 	if value, ok := su.mutation.UserID(); ok {
 		_spec.SetField(session.FieldUserID, field.TypeUint64, value)
 	}
@@ -131,6 +245,30 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Expiry(); ok {
 		_spec.SetField(session.FieldExpiry, field.TypeTime, value)
 	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if su.mutation.IPAddressCleared() {
+		_spec.ClearField(session.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := su.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if su.mutation.UserAgentCleared() {
+		_spec.ClearField(session.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := su.mutation.Device(); ok {
+		_spec.SetField(session.FieldDevice, field.TypeString, value)
+	}
+	if su.mutation.DeviceCleared() {
+		_spec.ClearField(session.FieldDevice, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{session.Label}
@@ -151,6 +289,26 @@ type SessionUpdateOne struct {
 	mutation *SessionMutation
 }
 
+// SetPublicID sets the "public_id" field.
+func (suo *SessionUpdateOne) SetPublicID(s string) *SessionUpdateOne {
+	suo.mutation.SetPublicID(s)
+	return suo
+}
+
+// SetNillablePublicID sets the "public_id" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillablePublicID(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetPublicID(*s)
+	}
+	return suo
+}
+
+// ClearPublicID clears the value of the "public_id" field.
+func (suo *SessionUpdateOne) ClearPublicID() *SessionUpdateOne {
+	suo.mutation.ClearPublicID()
+	return suo
+}
+
 // SetUserID sets the "user_id" field.
 func (suo *SessionUpdateOne) SetUserID(u uint64) *SessionUpdateOne {
 	suo.mutation.ResetUserID()
@@ -198,6 +356,94 @@ func (suo *SessionUpdateOne) SetNillableExpiry(t *time.Time) *SessionUpdateOne {
 	return suo
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (suo *SessionUpdateOne) SetCreatedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableCreatedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (suo *SessionUpdateOne) SetLastSeenAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetLastSeenAt(t)
+	return suo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableLastSeenAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetLastSeenAt(*t)
+	}
+	return suo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (suo *SessionUpdateOne) SetIPAddress(s string) *SessionUpdateOne {
+	suo.mutation.SetIPAddress(s)
+	return suo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableIPAddress(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetIPAddress(*s)
+	}
+	return suo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (suo *SessionUpdateOne) ClearIPAddress() *SessionUpdateOne {
+	suo.mutation.ClearIPAddress()
+	return suo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (suo *SessionUpdateOne) SetUserAgent(s string) *SessionUpdateOne {
+	suo.mutation.SetUserAgent(s)
+	return suo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableUserAgent(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetUserAgent(*s)
+	}
+	return suo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (suo *SessionUpdateOne) ClearUserAgent() *SessionUpdateOne {
+	suo.mutation.ClearUserAgent()
+	return suo
+}
+
+// SetDevice sets the "device" field.
+func (suo *SessionUpdateOne) SetDevice(s string) *SessionUpdateOne {
+	suo.mutation.SetDevice(s)
+	return suo
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableDevice(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetDevice(*s)
+	}
+	return suo
+}
+
+// ClearDevice clears the value of the "device" field.
+func (suo *SessionUpdateOne) ClearDevice() *SessionUpdateOne {
+	suo.mutation.ClearDevice()
+	return suo
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (suo *SessionUpdateOne) Mutation() *SessionMutation {
 	return suo.mutation
@@ -269,6 +515,12 @@ func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err e
 			}
 		}
 	}
+	if value, ok := suo.mutation.PublicID(); ok {
+		_spec.SetField(session.FieldPublicID, field.TypeString, value)
+	}
+	if suo.mutation.PublicIDCleared() {
+		_spec.ClearField(session.FieldPublicID, field.TypeString)
+	}
 	if value, ok := suo.mutation.UserID(); ok {
 		_spec.SetField(session.FieldUserID, field.TypeUint64, value)
 	}
@@ -284,6 +536,30 @@ func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err e
 	if value, ok := suo.mutation.Expiry(); ok {
 		_spec.SetField(session.FieldExpiry, field.TypeTime, value)
 	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if suo.mutation.IPAddressCleared() {
+		_spec.ClearField(session.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := suo.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if suo.mutation.UserAgentCleared() {
+		_spec.ClearField(session.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := suo.mutation.Device(); ok {
+		_spec.SetField(session.FieldDevice, field.TypeString, value)
+	}
+	if suo.mutation.DeviceCleared() {
+		_spec.ClearField(session.FieldDevice, field.TypeString)
+	}
 	_node = &Session{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -23,6 +23,12 @@ const (
 	FieldPassword = "password"
 	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
 	FieldVerifiedAt = "verified_at"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 )
@@ -36,6 +42,9 @@ var Columns = []string{
 	FieldEmail,
 	FieldPassword,
 	FieldVerifiedAt,
+	FieldRoles,
+	FieldDisabledAt,
+	FieldExternalID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -48,6 +57,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultRoles holds the default value on creation for the "roles" field.
+	DefaultRoles []string
+)
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,3 +99,13 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
 }
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
@@ -88,6 +88,40 @@ func (uc *UserCreate) SetNillableVerifiedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetRoles sets the "roles" field.
+func (uc *UserCreate) SetRoles(s []string) *UserCreate {
+	uc.mutation.SetRoles(s)
+	return uc
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uc *UserCreate) SetDisabledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDisabledAt(t)
+	return uc
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisabledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDisabledAt(*t)
+	}
+	return uc
+}
+
+// SetExternalID sets the "external_id" field.
+func (uc *UserCreate) SetExternalID(s string) *UserCreate {
+	uc.mutation.SetExternalID(s)
+	return uc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExternalID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetExternalID(*s)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uint64) *UserCreate {
 	uc.mutation.SetID(u)
@@ -101,6 +135,7 @@ func (uc *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+	uc.defaults()
 	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
 }
 
@@ -126,6 +161,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.Roles(); !ok {
+		v := user.DefaultRoles
+		uc.mutation.SetRoles(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Email(); !ok {
@@ -134,6 +177,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Password(); !ok {
 		return &ValidationError{Name: "password", err: errors.New(`gen: missing required field "User.password"`)}
 	}
+	if _, ok := uc.mutation.Roles(); !ok {
+		return &ValidationError{Name: "roles", err: errors.New(`gen: missing required field "User.roles"`)}
+	}
 	return nil
 }
 
@@ -190,6 +236,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
 		_node.VerifiedAt = &value
 	}
+	if value, ok := uc.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := uc.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
+	if value, ok := uc.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
 	return _node, _spec
 }
 
@@ -211,6 +269,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserMutation)
 				if !ok {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/gmhafiz/go8/ent/gen/predicate"
 	"github.com/gmhafiz/go8/ent/gen/user"
@@ -136,6 +137,58 @@ func (uu *UserUpdate) ClearVerifiedAt() *UserUpdate {
 	return uu
 }
 
+// SetRoles sets the "roles" field.
+func (uu *UserUpdate) SetRoles(s []string) *UserUpdate {
+	uu.mutation.SetRoles(s)
+	return uu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (uu *UserUpdate) AppendRoles(s []string) *UserUpdate {
+	uu.mutation.AppendRoles(s)
+	return uu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uu *UserUpdate) SetDisabledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDisabledAt(t)
+	return uu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisabledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDisabledAt(*t)
+	}
+	return uu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uu *UserUpdate) ClearDisabledAt() *UserUpdate {
+	uu.mutation.ClearDisabledAt()
+	return uu
+}
+
+// SetExternalID sets the "external_id" field.
+func (uu *UserUpdate) SetExternalID(s string) *UserUpdate {
+	uu.mutation.SetExternalID(s)
+	return uu
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableExternalID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetExternalID(*s)
+	}
+	return uu
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (uu *UserUpdate) ClearExternalID() *UserUpdate {
+	uu.mutation.ClearExternalID()
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -207,6 +260,26 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.VerifiedAtCleared() {
 		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldRoles, value)
+		})
+	}
+	if value, ok := uu.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+	}
+	if uu.mutation.ExternalIDCleared() {
+		_spec.ClearField(user.FieldExternalID, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -335,6 +408,58 @@ func (uuo *UserUpdateOne) ClearVerifiedAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetRoles sets the "roles" field.
+func (uuo *UserUpdateOne) SetRoles(s []string) *UserUpdateOne {
+	uuo.mutation.SetRoles(s)
+	return uuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (uuo *UserUpdateOne) AppendRoles(s []string) *UserUpdateOne {
+	uuo.mutation.AppendRoles(s)
+	return uuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uuo *UserUpdateOne) SetDisabledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDisabledAt(t)
+	return uuo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisabledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDisabledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uuo *UserUpdateOne) ClearDisabledAt() *UserUpdateOne {
+	uuo.mutation.ClearDisabledAt()
+	return uuo
+}
+
+// SetExternalID sets the "external_id" field.
+func (uuo *UserUpdateOne) SetExternalID(s string) *UserUpdateOne {
+	uuo.mutation.SetExternalID(s)
+	return uuo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableExternalID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetExternalID(*s)
+	}
+	return uuo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (uuo *UserUpdateOne) ClearExternalID() *UserUpdateOne {
+	uuo.mutation.ClearExternalID()
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -436,6 +561,26 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.VerifiedAtCleared() {
 		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldRoles, value)
+		})
+	}
+	if value, ok := uuo.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+	}
+	if uuo.mutation.ExternalIDCleared() {
+		_spec.ClearField(user.FieldExternalID, field.TypeString)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditCheckpoint holds the schema definition for the AuditCheckpoint entity.
+// Each is a signed statement of the newest audit log hash at the time.
+type AuditCheckpoint struct {
+	ent.Schema
+}
+
+// Fields of the AuditCheckpoint.
+func (AuditCheckpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.Uint64("audit_log_id"),
+		field.String("hash"),
+		// signature is a base64 ed25519 signature over audit_log_id, hash and
+		// created_at.
+		field.String("signature"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
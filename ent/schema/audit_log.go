@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity. It is an
+// append-only record of changes made to other entities.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		// actor_id is empty when a change is not made by a logged-in user.
+		field.Uint64("actor_id").Optional().Nillable(),
+		field.String("table_name"),
+		field.Uint64("table_row_id"),
+		// action is one of create, update or delete.
+		field.String("action"),
+		// old_values and new_values only hold the fields a change touched.
+		field.JSON("old_values", json.RawMessage{}).Optional(),
+		field.JSON("new_values", json.RawMessage{}).Optional(),
+		field.String("http_method").Optional(),
+		field.String("url").Optional(),
+		field.String("ip_address").Optional(),
+		field.String("user_agent").Optional(),
+		field.Time("created_at").Default(time.Now),
+		// prev_hash and hash chain entries in ID order. They are empty on
+		// entries saved before chaining began.
+		field.String("prev_hash").Optional().Nillable(),
+		field.String("hash").Optional().Nillable(),
+	}
+}
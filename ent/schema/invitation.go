@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity. An
+// invitation code lets up to max_uses people register, each receiving roles.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.String("code_hash").Unique().Sensitive(),
+		// created_by is cleared when the inviting user is deleted.
+		field.Uint64("created_by").Optional().Nillable(),
+		field.Strings("roles").Default([]string{}),
+		field.Int("max_uses").Default(1),
+		field.Int("uses").Default(0),
+		field.Time("expires_at"),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// InvitationUse holds the schema definition for the InvitationUse entity. It
+// records who registered with an invitation.
+type InvitationUse struct {
+	ent.Schema
+}
+
+// Fields of the InvitationUse.
+func (InvitationUse) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.Uint64("invitation_id"),
+		field.Uint64("user_id"),
+		field.Time("used_at").Default(time.Now),
+	}
+}
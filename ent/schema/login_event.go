@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginEvent holds the schema definition for the LoginEvent entity. It is an
+// append-only history of authentication events of a user.
+type LoginEvent struct {
+	ent.Schema
+}
+
+// Fields of the LoginEvent.
+func (LoginEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		// user_id is empty for a failed login with an unknown email.
+		field.Uint64("user_id").Optional().Nillable(),
+		field.String("type"),
+		// method is how a user logged in, such as password or magic_link.
+		field.String("method").Optional(),
+		// reason is why a login failed.
+		field.String("reason").Optional(),
+		field.String("ip_address").Optional(),
+		// network is the /24 IPv4 or /48 IPv6 prefix of ip_address, used to
+		// tell whether a login comes from a new network.
+		field.String("network").Optional(),
+		field.String("user_agent").Optional(),
+		field.String("device").Optional(),
+		field.Bool("new_device").Default(false),
+		field.Time("created_at").Default(time.Now),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// MagicLink holds the schema definition for the MagicLink entity. A link can
+// only be used once, from the browser that requested it.
+type MagicLink struct {
+	ent.Schema
+}
+
+// Fields of the MagicLink.
+func (MagicLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.Uint64("user_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		// nonce_hash binds the link to a cookie set in the requesting browser.
+		field.String("nonce_hash").Sensitive(),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
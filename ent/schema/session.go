@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
@@ -14,8 +16,14 @@ type Session struct {
 func (Session) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").StorageKey("token"),
+		field.String("public_id").Optional(),
 		field.Uint64("user_id").Nillable().Optional(),
 		field.Bytes("data"),
 		field.Time("expiry"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_seen_at").Default(time.Now),
+		field.String("ip_address").Optional(),
+		field.String("user_agent").Optional(),
+		field.String("device").Optional(),
 	}
 }
@@ -20,5 +20,10 @@ func (User) Fields() []ent.Field {
 		field.String("email"),
 		field.String("password"),
 		field.Time("verified_at").Optional().Nillable().StructTag(`json:"-"`),
+		field.Strings("roles").Default([]string{}),
+		field.Time("disabled_at").Optional().Nillable(),
+		// external_id identifies the user in a provisioning system such as a
+		// SCIM client.
+		field.String("external_id").Optional().Nillable().Unique(),
 	}
 }
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/gmhafiz/go8/internal/utility/filter"
+)
+
+type Filter struct {
+	Base filter.Filter
+
+	// Email and Name match partially and ignore case. Name is matched against
+	// first, middle and last names.
+	Email string
+	Name  string
+	Role  string
+	// Disabled and Verified are not filtered on when nil.
+	Disabled *bool
+	Verified *bool
+}
+
+func Filters(queries url.Values) *Filter {
+	return &Filter{
+		Base:     *filter.New(queries),
+		Email:    queries.Get("email"),
+		Name:     queries.Get("name"),
+		Role:     queries.Get("role"),
+		Disabled: parseBool(queries, "disabled"),
+		Verified: parseBool(queries, "verified"),
+	}
+}
+
+func parseBool(queries url.Values, key string) *bool {
+	b, err := strconv.ParseBool(queries.Get(key))
+	if err != nil {
+		return nil
+	}
+	return &b
+}
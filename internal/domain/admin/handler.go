@@ -0,0 +1,157 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/param"
+	"github.com/gmhafiz/go8/internal/utility/request"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+var ErrDisableSelf = errors.New("you cannot disable your own account")
+
+type Handler struct {
+	repo Repo
+}
+
+func NewHandler(repo Repo) *Handler {
+	return &Handler{
+		repo: repo,
+	}
+}
+
+// List users
+// @Summary List users
+// @Description Lists users with pagination. Requires admin role.
+// @Accept json
+// @Produce json,application/msgpack,application/cbor,text/csv
+// @Param page query string false "page number"
+// @Param limit query string false "limit of result"
+// @Param offset query string false "result offset"
+// @Param email query string false "search by email"
+// @Param name query string false "search by first, middle or last name"
+// @Param role query string false "filter by role"
+// @Param disabled query bool false "filter by disabled status"
+// @Param verified query bool false "filter by verified status"
+// @Param sort query string false "sort by fields name. E.g. email,asc"
+// @Success 200 {object} respond.Standard
+// @Failure 406 {string} Not Acceptable
+// @Failure 500 {string} Internal Server Error
+// @router /api/v1/admin/users [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	users, total, err := h.repo.List(r.Context(), Filters(r.URL.Query()))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Render(w, r, http.StatusOK, respond.Standard{
+		Data: UserResources(users),
+		Meta: respond.Meta{
+			Size:  len(users),
+			Total: total,
+		},
+	})
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	u, err := h.repo.Read(r.Context(), userID)
+	if err != nil {
+		respondRepoError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, UserResource(u))
+}
+
+// Disable stops a user from logging in and immediately rejects their live
+// sessions and personal access tokens.
+func (h *Handler) Disable(w http.ResponseWriter, r *http.Request) {
+	h.setDisabled(w, r, true)
+}
+
+func (h *Handler) Enable(w http.ResponseWriter, r *http.Request) {
+	h.setDisabled(w, r, false)
+}
+
+func (h *Handler) setDisabled(w http.ResponseWriter, r *http.Request, disabled bool) {
+	ctx := r.Context()
+
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	// Prevents an admin from locking everyone out by accident.
+	if currentUser, _ := middleware.UserID(ctx); disabled && currentUser == userID {
+		respond.Error(w, http.StatusBadRequest, ErrDisableSelf)
+		return
+	}
+
+	u, err := h.repo.SetDisabled(ctx, userID, disabled)
+	if err != nil {
+		respondRepoError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, UserResource(u))
+}
+
+func (h *Handler) SetVerified(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req SetVerifiedRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	u, err := h.repo.SetVerified(r.Context(), userID, req.VerifiedAt)
+	if err != nil {
+		respondRepoError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, UserResource(u))
+}
+
+// RevokeSessions logs a user out of every device.
+func (h *Handler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := h.repo.RevokeSessions(r.Context(), userID)
+	if err != nil {
+		respondRepoError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, RevokeSessionsResponse{Revoked: revoked})
+}
+
+func respondRepoError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrSessionsNotSupported):
+		respond.Error(w, http.StatusNotImplemented, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,258 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/param"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+const (
+	adminID    uint64 = 1
+	userID     uint64 = 2
+	disabledID uint64 = 3
+)
+
+type fakeRepo struct {
+	users map[uint64]*gen.User
+}
+
+func newFakeRepo() *fakeRepo {
+	disabledAt := time.Now()
+	return &fakeRepo{users: map[uint64]*gen.User{
+		adminID:    {ID: adminID, Email: "admin@example.com", Roles: []string{middleware.RoleAdmin}},
+		userID:     {ID: userID, Email: "user@example.com"},
+		disabledID: {ID: disabledID, Email: "disabled@example.com", Roles: []string{middleware.RoleAdmin}, DisabledAt: &disabledAt},
+	}}
+}
+
+func (f *fakeRepo) List(_ context.Context, _ *Filter) ([]*gen.User, int, error) {
+	users := []*gen.User{f.users[adminID], f.users[userID], f.users[disabledID]}
+	return users, len(users), nil
+}
+
+func (f *fakeRepo) Read(_ context.Context, id uint64) (*gen.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (f *fakeRepo) SetDisabled(_ context.Context, id uint64, disabled bool) (*gen.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	u.DisabledAt = nil
+	if disabled {
+		now := time.Now()
+		u.DisabledAt = &now
+	}
+	return u, nil
+}
+
+func (f *fakeRepo) SetVerified(_ context.Context, id uint64, verifiedAt *time.Time) (*gen.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	u.VerifiedAt = verifiedAt
+	return u, nil
+}
+
+func (f *fakeRepo) RevokeSessions(_ context.Context, id uint64) (int64, error) {
+	if _, ok := f.users[id]; !ok {
+		return 0, ErrUserNotFound
+	}
+	return 0, ErrSessionsNotSupported
+}
+
+// FindUser makes fakeRepo a middleware.UserStore as well.
+func (f *fakeRepo) FindUser(_ context.Context, id uint64) ([]string, bool, bool, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, false, false, nil
+	}
+	return u.Roles, u.DisabledAt != nil, true, nil
+}
+
+// memCtxStore adapts memstore to scs.CtxStore so that Authenticate middleware
+// can be used without a database.
+type memCtxStore struct {
+	*memstore.MemStore
+}
+
+func (m memCtxStore) DeleteCtx(_ context.Context, token string) error {
+	return m.Delete(token)
+}
+
+func (m memCtxStore) FindCtx(_ context.Context, token string) ([]byte, bool, error) {
+	return m.Find(token)
+}
+
+func (m memCtxStore) CommitCtx(_ context.Context, token string, b []byte, expiry time.Time) error {
+	return m.Commit(token, b, expiry)
+}
+
+func newRouter(repo *fakeRepo) *chi.Mux {
+	store := memCtxStore{memstore.New()}
+
+	session := scs.New()
+	session.Store = store
+	session.CtxStore = store
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+
+	// Stands in for a real login.
+	router.Post("/login/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := param.UInt64(r, "userID")
+		session.Put(r.Context(), string(middleware.KeyID), id)
+	})
+
+	RegisterHTTPEndPoints(router, session, repo, middleware.WithUserStore(repo))
+
+	return router
+}
+
+func login(t *testing.T, router *chi.Mux, id uint64) *http.Cookie {
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, httptest.NewRequest(http.MethodPost, "/login/"+strconv.FormatUint(id, 10), nil))
+
+	cookies := ww.Result().Cookies()
+	assert.Len(t, cookies, 1)
+
+	return cookies[0]
+}
+
+func TestHandler_Authorization(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID uint64
+		status int
+	}{
+		{name: "admin", userID: adminID, status: http.StatusOK},
+		{name: "not an admin", userID: userID, status: http.StatusForbidden},
+		{name: "disabled admin with live session", userID: disabledID, status: http.StatusUnauthorized},
+		{name: "not logged in", status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRouter(newFakeRepo())
+
+			rr := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+			if tt.userID != 0 {
+				rr.AddCookie(login(t, router, tt.userID))
+			}
+			ww := httptest.NewRecorder()
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, tt.status, ww.Code)
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	router := newRouter(newFakeRepo())
+
+	rr := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+	rr.AddCookie(login(t, router, adminID))
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var got struct {
+		Data []UserResponse `json:"data"`
+		Meta respond.Meta   `json:"meta"`
+	}
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Equal(t, 3, got.Meta.Total)
+	assert.Equal(t, 3, got.Meta.Size)
+	assert.Equal(t, []string{middleware.RoleAdmin}, got.Data[0].Roles)
+	assert.Equal(t, []string{}, got.Data[1].Roles)
+	assert.NotNil(t, got.Data[2].DisabledAt)
+}
+
+func TestHandler_Disable(t *testing.T) {
+	repo := newFakeRepo()
+	router := newRouter(repo)
+
+	adminCookie := login(t, router, adminID)
+	userCookie := login(t, router, userID)
+
+	// Cannot disable themselves.
+	rr := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/1/disable", nil)
+	rr.AddCookie(adminCookie)
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+
+	rr = httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/2/disable", nil)
+	rr.AddCookie(adminCookie)
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.NotNil(t, repo.users[userID].DisabledAt)
+
+	// Disabled user's session that is still live is rejected. Grant admin
+	// role so that it can only fail because of being disabled.
+	repo.users[userID].Roles = []string{middleware.RoleAdmin}
+	rr = httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+	rr.AddCookie(userCookie)
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusUnauthorized, ww.Code)
+
+	rr = httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/2/enable", nil)
+	rr.AddCookie(adminCookie)
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.Nil(t, repo.users[userID].DisabledAt)
+}
+
+func TestHandler_SetVerified(t *testing.T) {
+	repo := newFakeRepo()
+	router := newRouter(repo)
+	cookie := login(t, router, adminID)
+
+	rr := httptest.NewRequest(http.MethodPut, "/api/v1/admin/users/2/verified_at",
+		strings.NewReader(`{"verified_at": "2026-10-18T00:00:00Z"}`))
+	rr.AddCookie(cookie)
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.NotNil(t, repo.users[userID].VerifiedAt)
+
+	rr = httptest.NewRequest(http.MethodPut, "/api/v1/admin/users/2/verified_at",
+		strings.NewReader(`{"verified_at": null}`))
+	rr.AddCookie(cookie)
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.Nil(t, repo.users[userID].VerifiedAt)
+
+	rr = httptest.NewRequest(http.MethodPut, "/api/v1/admin/users/99/verified_at",
+		strings.NewReader(`{"verified_at": null}`))
+	rr.AddCookie(cookie)
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusNotFound, ww.Code)
+}
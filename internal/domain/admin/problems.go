@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+func init() {
+	respond.RegisterProblem(ErrUserNotFound, respond.ProblemType{
+		Type:   "/problems/user-not-found",
+		Title:  "User not found",
+		Status: http.StatusNotFound,
+	})
+	respond.RegisterProblem(ErrSessionsNotSupported, respond.ProblemType{
+		Type:   "/problems/sessions-not-supported",
+		Title:  "Sessions not supported",
+		Status: http.StatusNotImplemented,
+	})
+}
@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+// RegisterHTTPEndPoints registers admin routes. auth must include
+// middleware.WithUserStore so that roles of the current user are known.
+func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo, auth ...middleware.AuthOption) {
+	h := NewHandler(repo)
+
+	router.Route("/api/v1/admin/users", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session, auth...))
+		router.Use(middleware.RequireRole(middleware.RoleAdmin))
+		router.Get("/", h.List)
+		router.Get("/{userID}", h.Get)
+		router.Post("/{userID}/disable", h.Disable)
+		router.Post("/{userID}/enable", h.Enable)
+		router.Put("/{userID}/verified_at", h.SetVerified)
+		router.Delete("/{userID}/sessions", h.RevokeSessions)
+	})
+}
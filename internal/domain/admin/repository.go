@@ -0,0 +1,195 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+var (
+	ErrUserNotFound         = errors.New("user not found")
+	ErrSessionsNotSupported = errors.New("session store does not track user sessions")
+)
+
+type Repo interface {
+	List(ctx context.Context, f *Filter) ([]*gen.User, int, error)
+	Read(ctx context.Context, userID uint64) (*gen.User, error)
+	// SetDisabled disables or enables a user. Disabling also revokes all of the
+	// user's sessions.
+	SetDisabled(ctx context.Context, userID uint64, disabled bool) (*gen.User, error)
+	SetVerified(ctx context.Context, userID uint64, verifiedAt *time.Time) (*gen.User, error)
+	RevokeSessions(ctx context.Context, userID uint64) (int64, error)
+}
+
+type repo struct {
+	ent      *gen.Client
+	sessions middleware.UserSessionStore
+}
+
+func NewRepo(ent *gen.Client, manager *scs.SessionManager) *repo {
+	sessions, _ := manager.CtxStore.(middleware.UserSessionStore)
+
+	return &repo{
+		ent:      ent,
+		sessions: sessions,
+	}
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.User, int, error) {
+	predicates := userPredicates(f)
+
+	total, err := r.ent.User.Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get total user records: %w", err)
+	}
+
+	query := r.ent.User.Query().
+		Where(predicates...).
+		Order(userOrder(f.Base.Sort)...)
+	if !f.Base.DisablePaging {
+		query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	users, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get user records: %w", err)
+	}
+
+	return users, total, nil
+}
+
+func (r *repo) Read(ctx context.Context, userID uint64) (*gen.User, error) {
+	u, err := r.ent.User.Get(ctx, userID)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	}
+	return u, err
+}
+
+func (r *repo) SetDisabled(ctx context.Context, userID uint64, disabled bool) (*gen.User, error) {
+	update := r.ent.User.UpdateOneID(userID)
+	if disabled {
+		update.SetDisabledAt(time.Now())
+	} else {
+		update.ClearDisabledAt()
+	}
+
+	u, err := update.Save(ctx)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Authenticate middleware rejects a disabled user anyway, but their
+	// sessions are useless from now on.
+	if disabled && r.sessions != nil {
+		if _, err := r.sessions.DeleteAllByUserCtx(ctx, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return u, nil
+}
+
+func (r *repo) SetVerified(ctx context.Context, userID uint64, verifiedAt *time.Time) (*gen.User, error) {
+	update := r.ent.User.UpdateOneID(userID)
+	if verifiedAt == nil {
+		update.ClearVerifiedAt()
+	} else {
+		update.SetVerifiedAt(*verifiedAt)
+	}
+
+	u, err := update.Save(ctx)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	}
+	return u, err
+}
+
+func (r *repo) RevokeSessions(ctx context.Context, userID uint64) (int64, error) {
+	if r.sessions == nil {
+		return 0, ErrSessionsNotSupported
+	}
+
+	exist, err := r.ent.User.Query().Where(user.ID(userID)).Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !exist {
+		return 0, ErrUserNotFound
+	}
+
+	return r.sessions.DeleteAllByUserCtx(ctx, userID, "")
+}
+
+func userPredicates(f *Filter) []predicate.User {
+	var predicates []predicate.User
+	if f.Email != "" {
+		predicates = append(predicates, user.EmailContainsFold(f.Email))
+	}
+	if f.Name != "" {
+		predicates = append(predicates, user.Or(
+			user.FirstNameContainsFold(f.Name),
+			user.MiddleNameContainsFold(f.Name),
+			user.LastNameContainsFold(f.Name),
+		))
+	}
+	if f.Role != "" {
+		predicates = append(predicates, func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(user.FieldRoles, f.Role))
+		})
+	}
+	if f.Disabled != nil {
+		if *f.Disabled {
+			predicates = append(predicates, user.DisabledAtNotNil())
+		} else {
+			predicates = append(predicates, user.DisabledAtIsNil())
+		}
+	}
+	if f.Verified != nil {
+		if *f.Verified {
+			predicates = append(predicates, user.VerifiedAtNotNil())
+		} else {
+			predicates = append(predicates, user.VerifiedAtIsNil())
+		}
+	}
+
+	return predicates
+}
+
+func userOrder(sorts map[string]string) []user.OrderOption {
+	var orderFunc []user.OrderOption
+	for col, ord := range sorts {
+		direction := sql.OrderAsc()
+		if ord == "DESC" {
+			direction = sql.OrderDesc()
+		}
+
+		switch col {
+		case user.FieldID:
+			orderFunc = append(orderFunc, user.ByID(direction))
+		case user.FieldEmail:
+			orderFunc = append(orderFunc, user.ByEmail(direction))
+		case user.FieldFirstName:
+			orderFunc = append(orderFunc, user.ByFirstName(direction))
+		case user.FieldLastName:
+			orderFunc = append(orderFunc, user.ByLastName(direction))
+		}
+	}
+
+	// Keeps pages stable when sorting by a column with duplicates.
+	return append(orderFunc, user.ByID())
+}
@@ -0,0 +1,9 @@
+package admin
+
+import "time"
+
+// SetVerifiedRequest sets when the user's email was verified. A null
+// verified_at marks the user as unverified.
+type SetVerifiedRequest struct {
+	VerifiedAt *time.Time `json:"verified_at"`
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+)
+
+type UserResponse struct {
+	ID         uint64     `json:"id"`
+	FirstName  string     `json:"first_name"`
+	MiddleName string     `json:"middle_name"`
+	LastName   string     `json:"last_name"`
+	Email      string     `json:"email"`
+	Roles      []string   `json:"roles"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	DisabledAt *time.Time `json:"disabled_at"`
+}
+
+func UserResource(u *gen.User) UserResponse {
+	roles := u.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return UserResponse{
+		ID:         u.ID,
+		FirstName:  u.FirstName,
+		MiddleName: u.MiddleName,
+		LastName:   u.LastName,
+		Email:      u.Email,
+		Roles:      roles,
+		VerifiedAt: u.VerifiedAt,
+		DisabledAt: u.DisabledAt,
+	}
+}
+
+func UserResources(users []*gen.User) []UserResponse {
+	resources := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, UserResource(u))
+	}
+	return resources
+}
+
+type RevokeSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+// Entries form a chain in ID order. Each keeps the hash of the entry before it
+// as prev_hash, and its own hash is sha256(prev_hash || digest), where digest
+// is the sha256 of its content. Changing, inserting or removing an entry
+// breaks the link to the one after it. Removing the newest entries leaves
+// nothing after them to break, which is what signed checkpoints are for.
+
+// chainLock is the key of the advisory lock taken while appending to the
+// chain, so that concurrent writers do not link to the same entry.
+const chainLock = 7_315_402_118
+
+// insertChained appends an entry, linking it to the newest entry with a hash.
+// $12 is the digest of the entry's content.
+const insertChained = `
+INSERT INTO audit_logs (actor_id, table_name, table_row_id, action, old_values, new_values, http_method, url,
+                        ip_address, user_agent, created_at, prev_hash, hash)
+SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+       prev.hash,
+       encode(sha256(convert_to(prev.hash || $12, 'UTF8')), 'hex')
+FROM (SELECT coalesce((SELECT hash FROM audit_logs WHERE hash IS NOT NULL ORDER BY id DESC LIMIT 1), '') AS hash) AS prev
+`
+
+// content is what an entry's digest covers. Field order is fixed by the
+// struct, and JSON values are decoded and encoded again so that key order and
+// spacing, which Postgres does not keep in JSONB, do not matter.
+type content struct {
+	ActorID    *uint64 `json:"actor_id"`
+	Table      string  `json:"table_name"`
+	TableRowID uint64  `json:"table_row_id"`
+	Action     string  `json:"action"`
+	OldValues  any     `json:"old_values"`
+	NewValues  any     `json:"new_values"`
+	HTTPMethod string  `json:"http_method"`
+	URL        string  `json:"url"`
+	IPAddress  string  `json:"ip_address"`
+	UserAgent  string  `json:"user_agent"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// eventDigest is the digest of an event about to be saved. CreatedAt must
+// already be truncated to what Postgres keeps.
+func eventDigest(ev middleware.Event) (string, error) {
+	var actorID *uint64
+	if ev.ActorID != 0 {
+		actorID = &ev.ActorID
+	}
+
+	return digest(actorID, ev.Table, ev.TableRowID, string(ev.Action), ev.OldValues, ev.NewValues,
+		ev.HTTPMethod, ev.URL, ev.IPAddress, ev.UserAgent, ev.CreatedAt)
+}
+
+// logDigest is the digest of a saved entry.
+func logDigest(l *gen.AuditLog) (string, error) {
+	return digest(l.ActorID, l.TableName, l.TableRowID, l.Action, l.OldValues, l.NewValues,
+		l.HTTPMethod, l.URL, l.IPAddress, l.UserAgent, l.CreatedAt)
+}
+
+func digest(actorID *uint64, table string, rowID uint64, action string, oldValues, newValues json.RawMessage,
+	method, url, ip, userAgent string, createdAt time.Time) (string, error) {
+	c := content{
+		ActorID:    actorID,
+		Table:      table,
+		TableRowID: rowID,
+		Action:     action,
+		HTTPMethod: method,
+		URL:        url,
+		IPAddress:  ip,
+		UserAgent:  userAgent,
+		CreatedAt:  createdAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	var err error
+	if c.OldValues, err = canonical(oldValues); err != nil {
+		return "", err
+	}
+	if c.NewValues, err = canonical(newValues); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encoding audit entry: %w", err)
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonical decodes b so that encoding it again sorts keys and drops spacing.
+func canonical(b json.RawMessage) (any, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("decoding audit values: %w", err)
+	}
+	return v, nil
+}
+
+// link is the hash of an entry whose content has digest d, following the
+// entry with hash prev. It must match what insertChained computes.
+func link(prev, d string) string {
+	sum := sha256.Sum256([]byte(prev + d))
+	return hex.EncodeToString(sum[:])
+}
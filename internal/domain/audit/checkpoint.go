@@ -0,0 +1,159 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
+)
+
+// checkpointTimeout bounds each checkpoint so that a stuck database does not
+// hold up shutdown forever.
+const checkpointTimeout = 30 * time.Second
+
+// ParseSigningKey decodes a base64 ed25519 seed, as generated by
+// `openssl rand -base64 32`.
+func ParseSigningKey(s string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decoding audit signing key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("audit signing key must be %d bytes, got %d", ed25519.SeedSize, len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// checkpointMessage is what a checkpoint signs.
+func checkpointMessage(logID uint64, hash string, createdAt time.Time) []byte {
+	return []byte(strconv.FormatUint(logID, 10) + ":" + hash + ":" + createdAt.UTC().Format(time.RFC3339Nano))
+}
+
+// VerifyCheckpoint reports whether cp was signed by the key of pub.
+func VerifyCheckpoint(cp *gen.AuditCheckpoint, pub ed25519.PublicKey) bool {
+	sig, err := base64.StdEncoding.DecodeString(cp.Signature)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(pub, checkpointMessage(cp.AuditLogID, cp.Hash, cp.CreatedAt), sig)
+}
+
+// Checkpointer periodically signs the newest entry of the chain. Entries
+// removed from the end of the chain leave nothing behind to break, but the
+// last checkpoint still names them.
+type Checkpointer struct {
+	ent      *gen.Client
+	key      ed25519.PrivateKey
+	interval time.Duration
+
+	stop  chan struct{}
+	wg    sync.WaitGroup
+	close sync.Once
+}
+
+// NewCheckpointer starts signing the chain every interval with key.
+func NewCheckpointer(ent *gen.Client, key ed25519.PrivateKey, interval time.Duration) *Checkpointer {
+	c := &Checkpointer{
+		ent:      ent,
+		key:      key,
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+
+	c.wg.Add(1)
+	go c.work()
+
+	return c
+}
+
+// PublicKey verifies the checkpoints signed by c.
+func (c *Checkpointer) PublicKey() ed25519.PublicKey {
+	return c.key.Public().(ed25519.PublicKey)
+}
+
+// Close stops signing, after a last checkpoint.
+func (c *Checkpointer) Close() {
+	c.close.Do(func() {
+		close(c.stop)
+		c.wg.Wait()
+	})
+}
+
+func (c *Checkpointer) work() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.checkpoint()
+		case <-c.stop:
+			c.checkpoint()
+			return
+		}
+	}
+}
+
+func (c *Checkpointer) checkpoint() {
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointTimeout)
+	defer cancel()
+
+	if _, err := c.Checkpoint(ctx); err != nil {
+		slog.Error("signing audit checkpoint", "error", err)
+	}
+}
+
+// Checkpoint signs the newest entry of the chain. It returns nil when there is
+// nothing new to sign.
+func (c *Checkpointer) Checkpoint(ctx context.Context) (*gen.AuditCheckpoint, error) {
+	head, err := c.ent.AuditLog.Query().
+		Where(auditlog.HashNotNil()).
+		Order(auditlog.ByID(entsql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get newest audit log: %w", err)
+	}
+
+	last, err := c.ent.AuditCheckpoint.Query().
+		Order(auditcheckpoint.ByID(entsql.OrderDesc())).
+		First(ctx)
+	if err != nil && !gen.IsNotFound(err) {
+		return nil, fmt.Errorf("get latest audit checkpoint: %w", err)
+	}
+	if last != nil && last.AuditLogID == head.ID {
+		return nil, nil
+	}
+
+	// Postgres keeps microseconds, and the signature must match what is read
+	// back.
+	createdAt := time.Now().Truncate(time.Microsecond)
+	sig := ed25519.Sign(c.key, checkpointMessage(head.ID, *head.Hash, createdAt))
+
+	cp, err := c.ent.AuditCheckpoint.Create().
+		SetAuditLogID(head.ID).
+		SetHash(*head.Hash).
+		SetSignature(base64.StdEncoding.EncodeToString(sig)).
+		SetCreatedAt(createdAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save audit checkpoint: %w", err)
+	}
+
+	return cp, nil
+}
@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gmhafiz/go8/internal/utility/filter"
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+type Filter struct {
+	Base filter.Filter
+
+	Table string
+	// RowID and ActorID are not filtered on when nil.
+	RowID   *uint64
+	ActorID *uint64
+	// From is inclusive and To is exclusive. Both are RFC 3339 timestamps.
+	From *time.Time
+	To   *time.Time
+}
+
+// Filters reads audit log filters from query parameters. Unlike paging, a
+// malformed filter is an error rather than ignored, so that a typo does not
+// silently widen the search.
+func Filters(queries url.Values) (*Filter, error) {
+	f := &Filter{
+		Base:  *filter.New(queries),
+		Table: queries.Get("table"),
+	}
+
+	var err error
+	if f.RowID, err = parseUint(queries, "row_id"); err != nil {
+		return nil, err
+	}
+	if f.ActorID, err = parseUint(queries, "actor_id"); err != nil {
+		return nil, err
+	}
+	if f.From, err = parseTime(queries, "from"); err != nil {
+		return nil, err
+	}
+	if f.To, err = parseTime(queries, "to"); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func parseUint(queries url.Values, key string) (*uint64, error) {
+	if !queries.Has(key) {
+		return nil, nil
+	}
+	u, err := strconv.ParseUint(queries.Get(key), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a positive integer", ErrInvalidFilter, key)
+	}
+	return &u, nil
+}
+
+func parseTime(queries url.Values, key string) (*time.Time, error) {
+	if !queries.Has(key) {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, queries.Get(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an RFC 3339 timestamp", ErrInvalidFilter, key)
+	}
+	return &t, nil
+}
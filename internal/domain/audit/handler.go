@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+type Handler struct {
+	repo Repo
+	// publicKey verifies checkpoints. It is nil when checkpoints are not
+	// signed.
+	publicKey ed25519.PublicKey
+}
+
+func NewHandler(repo Repo, publicKey ed25519.PublicKey) *Handler {
+	return &Handler{
+		repo:      repo,
+		publicKey: publicKey,
+	}
+}
+
+// List audit logs
+// @Summary List audit logs
+// @Description Lists recorded changes newest first, with pagination. Requires admin role.
+// @Accept json
+// @Produce json,application/msgpack,application/cbor,text/csv
+// @Param page query string false "page number"
+// @Param limit query string false "limit of result"
+// @Param offset query string false "result offset"
+// @Param table query string false "filter by table name"
+// @Param row_id query int false "filter by row ID within the table"
+// @Param actor_id query int false "filter by the user who made the change"
+// @Param from query string false "changes made at or after this RFC 3339 time"
+// @Param to query string false "changes made before this RFC 3339 time"
+// @Success 200 {object} respond.Standard
+// @Failure 400 {string} Bad Request
+// @Failure 406 {string} Not Acceptable
+// @Failure 500 {string} Internal Server Error
+// @router /api/v1/admin/audit_logs [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	f, err := Filters(r.URL.Query())
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	logs, total, err := h.repo.List(r.Context(), f)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Render(w, r, http.StatusOK, respond.Standard{
+		Data: LogResources(logs),
+		Meta: respond.Meta{
+			Size:  len(logs),
+			Total: total,
+		},
+	})
+}
+
+// Checkpoint returns the latest signed checkpoint
+// @Summary Latest audit log checkpoint
+// @Description Returns the newest signed checkpoint of the audit log hash chain, with the base64 ed25519 public key that verifies it. The signature covers "<audit_log_id>:<hash>:<created_at in RFC 3339>". Requires admin role.
+// @Accept json
+// @Produce json
+// @Success 200 {object} CheckpointResponse
+// @Failure 404 {string} Not Found
+// @Failure 500 {string} Internal Server Error
+// @router /api/v1/admin/audit_logs/checkpoint [get]
+func (h *Handler) Checkpoint(w http.ResponseWriter, r *http.Request) {
+	if h.publicKey == nil {
+		respond.Error(w, http.StatusNotFound, errors.New("audit checkpoints are not enabled"))
+		return
+	}
+
+	cp, err := h.repo.LatestCheckpoint(r.Context())
+	if err != nil {
+		if errors.Is(err, ErrNoCheckpoint) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, CheckpointResource(cp, h.publicKey))
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+type fakeRepo struct {
+	logs       []*gen.AuditLog
+	filter     *Filter
+	checkpoint *gen.AuditCheckpoint
+}
+
+func (f *fakeRepo) List(_ context.Context, filter *Filter) ([]*gen.AuditLog, int, error) {
+	f.filter = filter
+	return f.logs, len(f.logs), nil
+}
+
+func (f *fakeRepo) LatestCheckpoint(context.Context) (*gen.AuditCheckpoint, error) {
+	if f.checkpoint == nil {
+		return nil, ErrNoCheckpoint
+	}
+	return f.checkpoint, nil
+}
+
+func TestHandler_List(t *testing.T) {
+	actorID := uint64(1)
+	repo := &fakeRepo{logs: []*gen.AuditLog{{
+		ID:         2,
+		ActorID:    &actorID,
+		TableName:  "users",
+		TableRowID: 3,
+		Action:     "update",
+		OldValues:  json.RawMessage(`{"first_name":"John"}`),
+		NewValues:  json.RawMessage(`{"first_name":"Jane"}`),
+	}}}
+	h := NewHandler(repo, nil)
+
+	rr := httptest.NewRecorder()
+	h.List(rr, httptest.NewRequest(http.MethodGet,
+		"/api/v1/admin/audit_logs?table=users&row_id=3&actor_id=1&from=2026-10-01T00:00:00Z&to=2026-11-01T00:00:00Z", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	assert.Equal(t, "users", repo.filter.Table)
+	assert.Equal(t, uint64(3), *repo.filter.RowID)
+	assert.Equal(t, uint64(1), *repo.filter.ActorID)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), *repo.filter.From)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), *repo.filter.To)
+
+	var got struct {
+		Data []LogResponse `json:"data"`
+		Meta respond.Meta  `json:"meta"`
+	}
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	assert.Equal(t, 1, got.Meta.Total)
+	assert.Len(t, got.Data, 1)
+	assert.Equal(t, "users", got.Data[0].Table)
+	assert.JSONEq(t, `{"first_name":"John"}`, string(got.Data[0].OldValues))
+}
+
+func TestHandler_ListInvalidFilter(t *testing.T) {
+	h := NewHandler(&fakeRepo{}, nil)
+
+	for _, query := range []string{"row_id=abc", "actor_id=-1", "from=yesterday", "to=2026-10-18"} {
+		t.Run(query, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			h.List(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit_logs?"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Checkpoint(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	pub := key.Public().(ed25519.PublicKey)
+
+	t.Run("not enabled", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		NewHandler(&fakeRepo{}, nil).Checkpoint(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit_logs/checkpoint", nil))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("none yet", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		NewHandler(&fakeRepo{}, pub).Checkpoint(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit_logs/checkpoint", nil))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("latest", func(t *testing.T) {
+		cp := sign(key, 4, "abc", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+		rr := httptest.NewRecorder()
+		NewHandler(&fakeRepo{checkpoint: cp}, pub).Checkpoint(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit_logs/checkpoint", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var got CheckpointResponse
+		assert.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+		assert.Equal(t, uint64(4), got.AuditLogID)
+		assert.Equal(t, "abc", got.Hash)
+
+		// What is returned is enough to check the signature.
+		decoded, err := base64.StdEncoding.DecodeString(got.PublicKey)
+		assert.NoError(t, err)
+		assert.True(t, VerifyCheckpoint(cp, decoded))
+	})
+}
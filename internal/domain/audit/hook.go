@@ -0,0 +1,257 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/author"
+	"github.com/gmhafiz/go8/ent/gen/book"
+	"github.com/gmhafiz/go8/ent/gen/emailverification"
+	"github.com/gmhafiz/go8/ent/gen/invitation"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+	"github.com/gmhafiz/go8/ent/gen/personalaccesstoken"
+	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/ent/gen/useridentity"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+// writeTimeout is how long a request waits for room in the Writer before its
+// events are given up on.
+const writeTimeout = 5 * time.Second
+
+// redacted replaces values of secret fields.
+const redacted = "[redacted]"
+
+// tables are the entities whose changes are recorded, by their ent type.
+// Audit logs, login events and sessions are left out as they are records of
+// activity themselves.
+var tables = map[string]string{
+	gen.TypeAuthor:              author.Table,
+	gen.TypeBook:                book.Table,
+	gen.TypeEmailVerification:   emailverification.Table,
+	gen.TypeInvitation:          invitation.Table,
+	gen.TypeInvitationUse:       invitationuse.Table,
+	gen.TypeMagicLink:           magiclink.Table,
+	gen.TypePersonalAccessToken: personalaccesstoken.Table,
+	gen.TypeUser:                user.Table,
+	gen.TypeUserIdentity:        useridentity.Table,
+}
+
+// Hook records changes made through ent during a request. A change outside a
+// request, such as from the seeder, is not recorded. Previous values are read
+// within the mutation's transaction, and the change fails if they cannot be.
+//
+// A change made in a transaction is recorded in that transaction, so that it
+// is not logged if the transaction is rolled back. Any other change has been
+// saved by the time it returns, and is queued into w.
+func Hook(w *Writer) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ev, ok := middleware.EventFromContext(ctx)
+			table, audited := tables[m.Type()]
+			if !ok || !audited {
+				return next.Mutate(ctx, m)
+			}
+
+			var old map[uint64]map[string]any
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				old, err = oldRows(ctx, m, table)
+				if err != nil {
+					return nil, fmt.Errorf("audit: reading old values of %s: %w", table, err)
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			ev.Table = table
+			events, err := changes(ev, m, old)
+			if err != nil {
+				slog.ErrorContext(ctx, "recording audit event", "table", table, "error", err)
+				return v, nil
+			}
+
+			// Within a transaction, the events are saved with the change so that
+			// they are rolled back with it.
+			if client, ok := txClient(m); ok {
+				if err := Record(ctx, client, events...); err != nil {
+					return nil, fmt.Errorf("audit: recording change of %s: %w", table, err)
+				}
+				return v, nil
+			}
+
+			writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+			defer cancel()
+			if err := w.Write(writeCtx, events...); err != nil {
+				slog.ErrorContext(ctx, "recording audit event", "table", table, "error", err)
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// txClient returns the client of the transaction m runs in, if any.
+func txClient(m ent.Mutation) (*gen.Client, bool) {
+	mutation, ok := m.(interface {
+		Client() *gen.Client
+		Tx() (*gen.Tx, error)
+	})
+	if !ok {
+		return nil, false
+	}
+	if _, err := mutation.Tx(); err != nil {
+		return nil, false
+	}
+	return mutation.Client(), true
+}
+
+// oldRows reads rows about to be changed by m, keyed by their ID.
+func oldRows(ctx context.Context, m ent.Mutation, table string) (map[uint64]map[string]any, error) {
+	mutation, ok := m.(interface {
+		Client() *gen.Client
+		IDs(ctx context.Context) ([]uint64, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("unsupported mutation %T", m)
+	}
+
+	ids, err := mutation.IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	t := sql.Table(table).As("t")
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(t.C("id"), "to_jsonb(t)").
+		From(t).
+		Where(sql.In(t.C("id"), args...)).
+		Query()
+
+	rows, err := mutation.Client().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	old := make(map[uint64]map[string]any, len(ids))
+	for rows.Next() {
+		var id uint64
+		var b []byte
+		if err := rows.Scan(&id, &b); err != nil {
+			return nil, err
+		}
+
+		var values map[string]any
+		if err := json.Unmarshal(b, &values); err != nil {
+			return nil, err
+		}
+		old[id] = values
+	}
+
+	return old, rows.Err()
+}
+
+// changes turns a completed mutation into an event per row. Only fields set or
+// cleared by the mutation are kept, except that a deleted row keeps all of its
+// previous values.
+func changes(ev middleware.Event, m ent.Mutation, old map[uint64]map[string]any) ([]middleware.Event, error) {
+	newValues := make(map[string]any)
+	for _, name := range m.Fields() {
+		newValues[name], _ = m.Field(name)
+	}
+	for _, name := range m.ClearedFields() {
+		newValues[name] = nil
+	}
+
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		mutation, ok := m.(interface{ ID() (uint64, bool) })
+		if !ok {
+			return nil, fmt.Errorf("unsupported mutation %T", m)
+		}
+		id, _ := mutation.ID()
+
+		ev.Action = middleware.ActionCreate
+		ev.TableRowID = id
+		values, err := marshal(newValues)
+		if err != nil {
+			return nil, err
+		}
+		ev.NewValues = values
+
+		return []middleware.Event{ev}, nil
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		events := make([]middleware.Event, 0, len(old))
+		for id, values := range old {
+			ev := ev
+			ev.Action = middleware.ActionDelete
+			ev.TableRowID = id
+			b, err := marshal(values)
+			if err != nil {
+				return nil, err
+			}
+			ev.OldValues = b
+			events = append(events, ev)
+		}
+		return events, nil
+	default:
+		newB, err := marshal(newValues)
+		if err != nil {
+			return nil, err
+		}
+
+		events := make([]middleware.Event, 0, len(old))
+		for id, values := range old {
+			oldValues := make(map[string]any, len(newValues))
+			for name := range newValues {
+				oldValues[name] = values[name]
+			}
+
+			ev := ev
+			ev.Action = middleware.ActionUpdate
+			ev.TableRowID = id
+			oldB, err := marshal(oldValues)
+			if err != nil {
+				return nil, err
+			}
+			ev.OldValues = oldB
+			ev.NewValues = newB
+			events = append(events, ev)
+		}
+		return events, nil
+	}
+}
+
+// marshal encodes values with secrets such as passwords and token hashes
+// redacted.
+func marshal(values map[string]any) (json.RawMessage, error) {
+	for name, v := range values {
+		if v != nil && isSecret(name) {
+			values[name] = redacted
+		}
+	}
+	return json.Marshal(values)
+}
+
+func isSecret(field string) bool {
+	return field == user.FieldPassword || strings.HasSuffix(field, "_hash")
+}
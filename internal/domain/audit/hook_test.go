@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+func decode(t *testing.T, b json.RawMessage) map[string]any {
+	t.Helper()
+
+	if b == nil {
+		return nil
+	}
+	var values map[string]any
+	if err := json.Unmarshal(b, &values); err != nil {
+		t.Fatal(err)
+	}
+	return values
+}
+
+func TestChanges(t *testing.T) {
+	// Mutations are only built, never run, so the client needs no database.
+	client := gen.NewClient()
+	ev := middleware.Event{Table: user.Table, HTTPMethod: "PATCH", URL: "/api/v1/me"}
+
+	t.Run("create", func(t *testing.T) {
+		m := client.User.Create().
+			SetEmail("email@example.com").
+			SetPassword("$argon2id$hash").
+			Mutation()
+		m.SetID(7)
+
+		events, err := changes(ev, m, nil)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		assert.Equal(t, middleware.ActionCreate, events[0].Action)
+		assert.Equal(t, uint64(7), events[0].TableRowID)
+		assert.Equal(t, "PATCH", events[0].HTTPMethod)
+		assert.Nil(t, events[0].OldValues)
+		assert.Equal(t, map[string]any{
+			"email":    "email@example.com",
+			"password": redacted,
+		}, decode(t, events[0].NewValues))
+	})
+
+	t.Run("update keeps only changed fields", func(t *testing.T) {
+		m := client.User.UpdateOneID(7).
+			SetFirstName("Jane").
+			ClearDisabledAt().
+			Mutation()
+		old := map[uint64]map[string]any{
+			7: {"id": 7, "first_name": "John", "email": "email@example.com", "disabled_at": "2026-10-18T00:00:00Z"},
+		}
+
+		events, err := changes(ev, m, old)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		assert.Equal(t, middleware.ActionUpdate, events[0].Action)
+		assert.Equal(t, uint64(7), events[0].TableRowID)
+		assert.Equal(t, map[string]any{
+			"first_name":  "John",
+			"disabled_at": "2026-10-18T00:00:00Z",
+		}, decode(t, events[0].OldValues))
+		assert.Equal(t, map[string]any{
+			"first_name":  "Jane",
+			"disabled_at": nil,
+		}, decode(t, events[0].NewValues))
+	})
+
+	t.Run("update of many rows records each", func(t *testing.T) {
+		m := client.User.Update().
+			SetPassword("$argon2id$new").
+			Mutation()
+		old := map[uint64]map[string]any{
+			1: {"password": "$argon2id$one"},
+			2: {"password": "$argon2id$two"},
+		}
+
+		events, err := changes(ev, m, old)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		for _, e := range events {
+			assert.Equal(t, map[string]any{"password": redacted}, decode(t, e.OldValues))
+			assert.Equal(t, map[string]any{"password": redacted}, decode(t, e.NewValues))
+		}
+	})
+
+	t.Run("delete keeps the whole row", func(t *testing.T) {
+		// Delete builders do not expose their mutation, so it is caught by a
+		// hook before reaching the database.
+		var m ent.Mutation
+		client.User.Use(func(ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(_ context.Context, mutation ent.Mutation) (ent.Value, error) {
+				m = mutation
+				return 0, nil
+			})
+		})
+		_, err := client.User.Delete().Where(user.ID(7)).Exec(context.Background())
+		assert.NoError(t, err)
+		old := map[uint64]map[string]any{
+			7: {"id": float64(7), "email": "email@example.com", "password": "$argon2id$hash"},
+		}
+
+		events, err := changes(ev, m, old)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		assert.Equal(t, middleware.ActionDelete, events[0].Action)
+		assert.Equal(t, map[string]any{
+			"id":       float64(7),
+			"email":    "email@example.com",
+			"password": redacted,
+		}, decode(t, events[0].OldValues))
+		assert.Nil(t, events[0].NewValues)
+	})
+}
+
+func TestEventFromContext(t *testing.T) {
+	_, ok := middleware.EventFromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := context.WithValue(context.Background(), middleware.KeyAuditID, middleware.Event{URL: "/api/v1/me"})
+	ev, ok := middleware.EventFromContext(ctx)
+	assert.True(t, ok)
+	assert.Zero(t, ev.ActorID)
+
+	// Authenticate saves the user once it runs, after Audit.
+	ctx = context.WithValue(ctx, middleware.KeyID, uint64(3))
+	ev, ok = middleware.EventFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), ev.ActorID)
+	assert.Equal(t, "/api/v1/me", ev.URL)
+}
@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+func init() {
+	respond.RegisterProblem(ErrInvalidFilter, respond.ProblemType{
+		Type:   "/problems/invalid-filter",
+		Title:  "Invalid filter",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(ErrNoCheckpoint, respond.ProblemType{
+		Type:   "/problems/no-checkpoint",
+		Title:  "No checkpoint",
+		Status: http.StatusNotFound,
+	})
+}
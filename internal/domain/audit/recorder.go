@@ -0,0 +1,160 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+// Execer runs a statement. *sql.DB, *sql.Tx, *sqlx.DB, *sqlx.Tx, and ent's
+// *gen.Client and *gen.Tx all satisfy it, so that events can be saved in the
+// same transaction as the change they describe.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// beginner starts its own transaction, as *sql.DB and *sqlx.DB do.
+type beginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// Record saves events through db. It is what both Writer and RecordChange use,
+// so every entry is saved the same way whichever path it takes.
+//
+// Entries are appended to the hash chain while holding a lock that is only
+// released when the transaction ends, so db must be a READ COMMITTED
+// transaction, or a *sql.DB that Record starts one on. Any other Execer runs
+// each statement in its own transaction and does not hold the lock.
+func Record(ctx context.Context, db Execer, events ...middleware.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pool, ok := db.(beginner)
+	if !ok {
+		return record(ctx, db, events)
+	}
+
+	tx, err := pool.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("saving audit events: %w", err)
+	}
+	if err = record(ctx, tx, events); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("saving audit events: %w", err)
+	}
+
+	return nil
+}
+
+func record(ctx context.Context, tx Execer, events []middleware.Event) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", chainLock); err != nil {
+		return fmt.Errorf("locking audit chain: %w", err)
+	}
+
+	// One statement per entry so that each sees the one inserted before it.
+	for _, ev := range events {
+		var actorID any
+		if ev.ActorID != 0 {
+			actorID = ev.ActorID
+		}
+		if ev.CreatedAt.IsZero() {
+			ev.CreatedAt = time.Now()
+		}
+		// Postgres keeps microseconds, and the digest must match what is read
+		// back.
+		ev.CreatedAt = ev.CreatedAt.Truncate(time.Microsecond)
+
+		d, err := eventDigest(ev)
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, insertChained,
+			actorID,
+			ev.Table,
+			ev.TableRowID,
+			string(ev.Action),
+			jsonValue(ev.OldValues),
+			jsonValue(ev.NewValues),
+			ev.HTTPMethod,
+			ev.URL,
+			ev.IPAddress,
+			ev.UserAgent,
+			ev.CreatedAt,
+			d,
+		); err != nil {
+			return fmt.Errorf("saving audit events: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RecordChange saves a change to a single row made without ent, such as
+// through sqlx. Pass the transaction making the change as db so that the entry
+// commits or rolls back along with it. Values are keyed by column name. Give
+// nil oldValues when creating and nil newValues when deleting. An update only
+// keeps the columns whose values differ. Like Hook, nothing is recorded
+// outside a request.
+func RecordChange(ctx context.Context, db Execer, table string, rowID uint64, action middleware.Action, oldValues, newValues map[string]any) error {
+	ev, ok := middleware.EventFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if action == middleware.ActionUpdate {
+		oldValues, newValues = diff(oldValues, newValues)
+	}
+
+	ev.Table = table
+	ev.TableRowID = rowID
+	ev.Action = action
+
+	var err error
+	if oldValues != nil {
+		if ev.OldValues, err = marshal(oldValues); err != nil {
+			return err
+		}
+	}
+	if newValues != nil {
+		if ev.NewValues, err = marshal(newValues); err != nil {
+			return err
+		}
+	}
+
+	return Record(ctx, db, ev)
+}
+
+// diff keeps only the columns whose values differ, compared by their JSON
+// encoding.
+func diff(oldValues, newValues map[string]any) (map[string]any, map[string]any) {
+	changedOld := make(map[string]any)
+	changedNew := make(map[string]any)
+
+	for name, v := range newValues {
+		was, _ := json.Marshal(oldValues[name])
+		is, _ := json.Marshal(v)
+		if string(was) != string(is) {
+			changedOld[name] = oldValues[name]
+			changedNew[name] = v
+		}
+	}
+
+	return changedOld, changedNew
+}
+
+// jsonValue turns a missing value into NULL rather than JSON null.
+func jsonValue(b json.RawMessage) any {
+	if b == nil {
+		return nil
+	}
+	return string(b)
+}
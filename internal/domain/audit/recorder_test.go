@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+type statement struct {
+	query string
+	args  []any
+}
+
+// fakeExecer keeps statements instead of running them. When release is set,
+// each statement waits for it to be closed first.
+type fakeExecer struct {
+	mu         sync.Mutex
+	statements []statement
+	release    chan struct{}
+}
+
+func (f *fakeExecer) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	if f.release != nil {
+		<-f.release
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statements = append(f.statements, statement{query: query, args: args})
+	return nil, nil
+}
+
+func (f *fakeExecer) saved() []statement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.statements
+}
+
+// inserts keeps the statements saving an entry, leaving out taking the lock.
+func inserts(statements []statement) []statement {
+	var saved []statement
+	for _, s := range statements {
+		if strings.Contains(s.query, "INSERT INTO audit_logs") {
+			saved = append(saved, s)
+		}
+	}
+	return saved
+}
+
+func TestRecord(t *testing.T) {
+	db := &fakeExecer{}
+
+	err := Record(context.Background(), db,
+		middleware.Event{Table: "books", TableRowID: 1, Action: middleware.ActionCreate, NewValues: []byte(`{"title":"a"}`)},
+		middleware.Event{Table: "books", TableRowID: 2, Action: middleware.ActionDelete, ActorID: 3, OldValues: []byte(`{"title":"b"}`)},
+	)
+	assert.NoError(t, err)
+
+	statements := db.saved()
+	assert.Len(t, statements, 3)
+	assert.Contains(t, statements[0].query, "pg_advisory_xact_lock", "expected the chain to be locked first")
+
+	// No actor, no old values of the creation and no new values of the
+	// deletion are saved as NULL rather than JSON null.
+	created, deleted := statements[1].args, statements[2].args
+	assert.Nil(t, created[0])
+	assert.Nil(t, created[4])
+	assert.Equal(t, `{"title":"a"}`, created[5])
+	assert.Equal(t, uint64(3), deleted[0])
+	assert.Equal(t, `{"title":"b"}`, deleted[4])
+	assert.Nil(t, deleted[5])
+
+	// Each entry carries the digest its hash is chained with.
+	for _, s := range statements[1:] {
+		createdAt := s.args[10].(time.Time)
+		assert.Equal(t, createdAt, createdAt.Truncate(time.Microsecond), "expected the precision Postgres keeps")
+		assert.Len(t, s.args[11], 64)
+	}
+	assert.NotEqual(t, created[11], deleted[11])
+}
+
+func TestRecordChange(t *testing.T) {
+	t.Run("outside a request", func(t *testing.T) {
+		db := &fakeExecer{}
+
+		err := RecordChange(context.Background(), db, "books", 1, middleware.ActionCreate, nil, map[string]any{"title": "a"})
+		assert.NoError(t, err)
+		assert.Empty(t, db.saved())
+	})
+
+	t.Run("update keeps changed columns", func(t *testing.T) {
+		db := &fakeExecer{}
+		ctx := context.WithValue(context.Background(), middleware.KeyAuditID, middleware.Event{URL: "/api/v1/book/1"})
+		ctx = context.WithValue(ctx, middleware.KeyID, uint64(5))
+
+		err := RecordChange(ctx, db, "books", 1, middleware.ActionUpdate,
+			map[string]any{"title": "a", "description": "same", "password": "old"},
+			map[string]any{"title": "b", "description": "same", "password": "new"},
+		)
+		assert.NoError(t, err)
+
+		statements := inserts(db.saved())
+		assert.Len(t, statements, 1)
+		args := statements[0].args
+		assert.Equal(t, uint64(5), args[0])
+		assert.Equal(t, "books", args[1])
+		assert.Equal(t, "update", args[3])
+		assert.JSONEq(t, `{"title":"a","password":"[redacted]"}`, args[4].(string))
+		assert.JSONEq(t, `{"title":"b","password":"[redacted]"}`, args[5].(string))
+		assert.Equal(t, "/api/v1/book/1", args[7])
+	})
+}
@@ -0,0 +1,24 @@
+package audit
+
+import (
+	"crypto/ed25519"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+// RegisterHTTPEndPoints registers audit log routes. auth must include
+// middleware.WithUserStore so that roles of the current user are known.
+// publicKey is nil when checkpoints are not signed.
+func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo, publicKey ed25519.PublicKey, auth ...middleware.AuthOption) {
+	h := NewHandler(repo, publicKey)
+
+	router.Route("/api/v1/admin/audit_logs", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session, auth...))
+		router.Use(middleware.RequireRole(middleware.RoleAdmin))
+		router.Get("/", h.List)
+		router.Get("/checkpoint", h.Checkpoint)
+	})
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+)
+
+type Repo interface {
+	// List returns audit logs newest first.
+	List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error)
+	// LatestCheckpoint returns ErrNoCheckpoint before the first is signed.
+	LatestCheckpoint(ctx context.Context) (*gen.AuditCheckpoint, error)
+}
+
+// ErrNoCheckpoint is returned before any checkpoint has been signed.
+var ErrNoCheckpoint = errors.New("no audit checkpoint yet")
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error) {
+	predicates := logPredicates(f)
+
+	total, err := r.ent.AuditLog.Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get total audit log records: %w", err)
+	}
+
+	query := r.ent.AuditLog.Query().
+		Where(predicates...).
+		Order(auditlog.ByID(entsql.OrderDesc()))
+	if !f.Base.DisablePaging {
+		query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	logs, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get audit log records: %w", err)
+	}
+
+	return logs, total, nil
+}
+
+func (r *repo) LatestCheckpoint(ctx context.Context) (*gen.AuditCheckpoint, error) {
+	cp, err := r.ent.AuditCheckpoint.Query().
+		Order(auditcheckpoint.ByID(entsql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNoCheckpoint
+		}
+		return nil, fmt.Errorf("get latest audit checkpoint: %w", err)
+	}
+
+	return cp, nil
+}
+
+func logPredicates(f *Filter) []predicate.AuditLog {
+	var predicates []predicate.AuditLog
+	if f.Table != "" {
+		predicates = append(predicates, auditlog.TableNameEQ(f.Table))
+	}
+	if f.RowID != nil {
+		predicates = append(predicates, auditlog.TableRowIDEQ(*f.RowID))
+	}
+	if f.ActorID != nil {
+		predicates = append(predicates, auditlog.ActorIDEQ(*f.ActorID))
+	}
+	if f.From != nil {
+		predicates = append(predicates, auditlog.CreatedAtGTE(*f.From))
+	}
+	if f.To != nil {
+		predicates = append(predicates, auditlog.CreatedAtLT(*f.To))
+	}
+	return predicates
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+)
+
+type LogResponse struct {
+	ID         uint64          `json:"id"`
+	ActorID    *uint64         `json:"actor_id"`
+	Table      string          `json:"table"`
+	TableRowID uint64          `json:"table_row_id"`
+	Action     string          `json:"action"`
+	OldValues  json.RawMessage `json:"old_values"`
+	NewValues  json.RawMessage `json:"new_values"`
+	HTTPMethod string          `json:"http_method"`
+	URL        string          `json:"url"`
+	IPAddress  string          `json:"ip_address"`
+	UserAgent  string          `json:"user_agent"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func LogResource(l *gen.AuditLog) LogResponse {
+	return LogResponse{
+		ID:         l.ID,
+		ActorID:    l.ActorID,
+		Table:      l.TableName,
+		TableRowID: l.TableRowID,
+		Action:     l.Action,
+		OldValues:  l.OldValues,
+		NewValues:  l.NewValues,
+		HTTPMethod: l.HTTPMethod,
+		URL:        l.URL,
+		IPAddress:  l.IPAddress,
+		UserAgent:  l.UserAgent,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+func LogResources(logs []*gen.AuditLog) []LogResponse {
+	resources := make([]LogResponse, 0, len(logs))
+	for _, l := range logs {
+		resources = append(resources, LogResource(l))
+	}
+	return resources
+}
+
+type CheckpointResponse struct {
+	ID         uint64    `json:"id"`
+	AuditLogID uint64    `json:"audit_log_id"`
+	Hash       string    `json:"hash"`
+	Signature  string    `json:"signature"`
+	PublicKey  string    `json:"public_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func CheckpointResource(cp *gen.AuditCheckpoint, pub ed25519.PublicKey) CheckpointResponse {
+	return CheckpointResponse{
+		ID:         cp.ID,
+		AuditLogID: cp.AuditLogID,
+		Hash:       cp.Hash,
+		Signature:  cp.Signature,
+		PublicKey:  base64.StdEncoding.EncodeToString(pub),
+		CreatedAt:  cp.CreatedAt,
+	}
+}
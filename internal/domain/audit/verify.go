@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/auditcheckpoint"
+	"github.com/gmhafiz/go8/ent/gen/auditlog"
+)
+
+// verifyBatch is how many entries are read at a time while walking the chain.
+const verifyBatch = 1000
+
+// Break is the first broken link found by Verify.
+type Break struct {
+	LogID uint64
+	// CheckpointID is set when it is a checkpoint that no longer holds.
+	CheckpointID uint64
+	Reason       string
+}
+
+func (b *Break) String() string {
+	if b.CheckpointID != 0 {
+		return fmt.Sprintf("checkpoint %d of audit log %d: %s", b.CheckpointID, b.LogID, b.Reason)
+	}
+	return fmt.Sprintf("audit log %d: %s", b.LogID, b.Reason)
+}
+
+// Result is what Verify found.
+type Result struct {
+	Entries     int
+	Checkpoints int
+	// Break is nil when the chain is intact.
+	Break *Break
+}
+
+// Verify walks the chain in ID order and stops at the first broken link. Then
+// each checkpoint must still name an entry with the hash it signed. Signatures
+// are only checked when pub is given. Entries saved before chaining began are
+// skipped.
+func Verify(ctx context.Context, client *gen.Client, pub ed25519.PublicKey) (*Result, error) {
+	checkpoints, err := client.AuditCheckpoint.Query().
+		Order(auditcheckpoint.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get audit checkpoints: %w", err)
+	}
+
+	c := newChain(checkpoints)
+	var after uint64
+	for {
+		logs, err := client.AuditLog.Query().
+			Where(auditlog.IDGT(after)).
+			Order(auditlog.ByID()).
+			Limit(verifyBatch).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get audit logs: %w", err)
+		}
+
+		for _, l := range logs {
+			b, err := c.next(l)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				return &Result{Entries: c.entries, Break: b}, nil
+			}
+		}
+
+		if len(logs) < verifyBatch {
+			break
+		}
+		after = logs[len(logs)-1].ID
+	}
+
+	return &Result{
+		Entries:     c.entries,
+		Checkpoints: len(checkpoints),
+		Break:       c.checkpoints(checkpoints, pub),
+	}, nil
+}
+
+// chain follows entries in ID order.
+type chain struct {
+	prev    string
+	started bool
+	entries int
+	// signed holds the hashes of checkpointed entries seen so far.
+	signed map[uint64]string
+}
+
+func newChain(checkpoints []*gen.AuditCheckpoint) *chain {
+	signed := make(map[uint64]string, len(checkpoints))
+	for _, cp := range checkpoints {
+		signed[cp.AuditLogID] = ""
+	}
+	return &chain{signed: signed}
+}
+
+// next checks that l links to the entry before it.
+func (c *chain) next(l *gen.AuditLog) (*Break, error) {
+	if l.Hash == nil {
+		if c.started {
+			return &Break{LogID: l.ID, Reason: "hash is missing"}, nil
+		}
+		return nil, nil
+	}
+	c.started = true
+
+	if l.PrevHash == nil || *l.PrevHash != c.prev {
+		return &Break{LogID: l.ID, Reason: "prev_hash does not match the hash of the entry before it"}, nil
+	}
+
+	d, err := logDigest(l)
+	if err != nil {
+		return nil, err
+	}
+	if link(c.prev, d) != *l.Hash {
+		return &Break{LogID: l.ID, Reason: "hash does not match its content"}, nil
+	}
+
+	if _, ok := c.signed[l.ID]; ok {
+		c.signed[l.ID] = *l.Hash
+	}
+	c.prev = *l.Hash
+	c.entries++
+
+	return nil, nil
+}
+
+// checkpoints checks each checkpoint against the entries seen.
+func (c *chain) checkpoints(checkpoints []*gen.AuditCheckpoint, pub ed25519.PublicKey) *Break {
+	for _, cp := range checkpoints {
+		if pub != nil && !VerifyCheckpoint(cp, pub) {
+			return &Break{LogID: cp.AuditLogID, CheckpointID: cp.ID, Reason: "signature is invalid"}
+		}
+
+		hash := c.signed[cp.AuditLogID]
+		if hash == "" {
+			return &Break{LogID: cp.AuditLogID, CheckpointID: cp.ID, Reason: "entry is missing"}
+		}
+		if hash != cp.Hash {
+			return &Break{LogID: cp.AuditLogID, CheckpointID: cp.ID, Reason: "hash differs from the one signed"}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/ent/gen"
+)
+
+// chained links entries the way insertChained does.
+func chained(t *testing.T, logs ...*gen.AuditLog) []*gen.AuditLog {
+	t.Helper()
+
+	prev := ""
+	for _, l := range logs {
+		d, err := logDigest(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		prevHash, hash := prev, link(prev, d)
+		l.PrevHash, l.Hash = &prevHash, &hash
+		prev = hash
+	}
+	return logs
+}
+
+func sign(key ed25519.PrivateKey, logID uint64, hash string, createdAt time.Time) *gen.AuditCheckpoint {
+	return &gen.AuditCheckpoint{
+		ID:         1,
+		AuditLogID: logID,
+		Hash:       hash,
+		Signature:  base64.StdEncoding.EncodeToString(ed25519.Sign(key, checkpointMessage(logID, hash, createdAt))),
+		CreatedAt:  createdAt,
+	}
+}
+
+func entries(t *testing.T) []*gen.AuditLog {
+	at := time.Date(2026, 10, 18, 9, 0, 0, 123456000, time.UTC)
+	return chained(t,
+		&gen.AuditLog{ID: 1, TableName: "books", TableRowID: 1, Action: "create", NewValues: json.RawMessage(`{"title":"a","year":2026}`), CreatedAt: at},
+		&gen.AuditLog{ID: 2, TableName: "books", TableRowID: 1, Action: "update", OldValues: json.RawMessage(`{"title":"a"}`), NewValues: json.RawMessage(`{"title":"b"}`), CreatedAt: at.Add(time.Second)},
+		&gen.AuditLog{ID: 3, TableName: "books", TableRowID: 1, Action: "delete", OldValues: json.RawMessage(`{"title":"b"}`), CreatedAt: at.Add(2 * time.Second)},
+	)
+}
+
+// walk runs logs through a chain the way Verify does.
+func walk(t *testing.T, logs []*gen.AuditLog, checkpoints []*gen.AuditCheckpoint, pub ed25519.PublicKey) *Break {
+	t.Helper()
+
+	c := newChain(checkpoints)
+	for _, l := range logs {
+		b, err := c.next(l)
+		assert.NoError(t, err)
+		if b != nil {
+			return b
+		}
+	}
+	return c.checkpoints(checkpoints, pub)
+}
+
+func TestDigest(t *testing.T) {
+	l := entries(t)[0]
+	d, err := logDigest(l)
+	assert.NoError(t, err)
+
+	// Postgres reorders keys and drops spacing in JSONB.
+	l.NewValues = json.RawMessage(`{ "year": 2026, "title": "a" }`)
+	reordered, err := logDigest(l)
+	assert.NoError(t, err)
+	assert.Equal(t, d, reordered)
+
+	// Read back in another time zone.
+	l.CreatedAt = l.CreatedAt.In(time.FixedZone("MYT", 8*60*60))
+	zoned, err := logDigest(l)
+	assert.NoError(t, err)
+	assert.Equal(t, d, zoned)
+
+	l.NewValues = json.RawMessage(`{"title":"a","year":2025}`)
+	changed, err := logDigest(l)
+	assert.NoError(t, err)
+	assert.NotEqual(t, d, changed)
+}
+
+func TestVerify(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	pub := key.Public().(ed25519.PublicKey)
+
+	t.Run("intact", func(t *testing.T) {
+		logs := entries(t)
+		cp := sign(key, 3, *logs[2].Hash, time.Now())
+		assert.Nil(t, walk(t, logs, []*gen.AuditCheckpoint{cp}, pub))
+	})
+
+	t.Run("entries before chaining began are skipped", func(t *testing.T) {
+		logs := append([]*gen.AuditLog{{ID: 0, TableName: "books"}}, entries(t)...)
+		assert.Nil(t, walk(t, logs, nil, pub))
+	})
+
+	t.Run("edited content", func(t *testing.T) {
+		logs := entries(t)
+		logs[1].NewValues = json.RawMessage(`{"title":"c"}`)
+		assert.Equal(t, &Break{LogID: 2, Reason: "hash does not match its content"}, walk(t, logs, nil, pub))
+	})
+
+	t.Run("edited content with its hash recomputed", func(t *testing.T) {
+		logs := entries(t)
+		logs[1].NewValues = json.RawMessage(`{"title":"c"}`)
+		d, _ := logDigest(logs[1])
+		hash := link(*logs[1].PrevHash, d)
+		logs[1].Hash = &hash
+		assert.Equal(t, uint64(3), walk(t, logs, nil, pub).LogID, "expected the next entry to no longer link")
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		logs := entries(t)
+		logs = append(logs[:1], logs[2])
+		assert.Equal(t, uint64(3), walk(t, logs, nil, pub).LogID)
+	})
+
+	t.Run("missing hash after chaining began", func(t *testing.T) {
+		logs := entries(t)
+		logs[1].Hash = nil
+		assert.Equal(t, &Break{LogID: 2, Reason: "hash is missing"}, walk(t, logs, nil, pub))
+	})
+
+	t.Run("newest entry removed after a checkpoint", func(t *testing.T) {
+		logs := entries(t)
+		cp := sign(key, 3, *logs[2].Hash, time.Now())
+		b := walk(t, logs[:2], []*gen.AuditCheckpoint{cp}, pub)
+		assert.Equal(t, &Break{LogID: 3, CheckpointID: 1, Reason: "entry is missing"}, b)
+	})
+
+	t.Run("forged checkpoint", func(t *testing.T) {
+		logs := entries(t)
+		forger := ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+		cp := sign(forger, 3, *logs[2].Hash, time.Now())
+
+		assert.Equal(t, "signature is invalid", walk(t, logs, []*gen.AuditCheckpoint{cp}, pub).Reason)
+		assert.Nil(t, walk(t, logs, []*gen.AuditCheckpoint{cp}, nil), "expected signatures to be skipped without a key")
+	})
+}
+
+func TestParseSigningKey(t *testing.T) {
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	key, err := ParseSigningKey(base64.StdEncoding.EncodeToString(seed))
+	assert.NoError(t, err)
+	assert.Equal(t, ed25519.NewKeyFromSeed(seed), key)
+
+	_, err = ParseSigningKey(base64.StdEncoding.EncodeToString(seed[:16]))
+	assert.Error(t, err)
+}
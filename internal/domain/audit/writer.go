@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+const (
+	// maxBatch is the most events saved in a single transaction.
+	maxBatch = 100
+	// saveTimeout bounds each insert so that a stuck database does not hold up
+	// shutdown forever.
+	saveTimeout = 30 * time.Second
+)
+
+// Writer saves audit events in the background so that a request does not wait
+// for them. Unlike mail.Queue, it never drops an event when full, Write waits
+// for room instead. Events still queued are lost if the process dies.
+type Writer struct {
+	db     Execer
+	events chan middleware.Event
+	wg     sync.WaitGroup
+	close  sync.Once
+}
+
+// NewWriter starts a worker saving into audit_logs through db, with room for
+// size events waiting to be saved.
+func NewWriter(db Execer, size int) *Writer {
+	w := &Writer{
+		db:     db,
+		events: make(chan middleware.Event, size),
+	}
+
+	w.wg.Add(1)
+	go w.work()
+
+	return w
+}
+
+// Write queues events, waiting for room while the queue is full. It only gives
+// up when ctx is done.
+func (w *Writer) Write(ctx context.Context, events ...middleware.Event) error {
+	for _, ev := range events {
+		// Timed when the change happened rather than when it is saved.
+		if ev.CreatedAt.IsZero() {
+			ev.CreatedAt = time.Now()
+		}
+
+		select {
+		case w.events <- ev:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Close waits for queued events to be saved. Write must not be called after
+// Close.
+func (w *Writer) Close() {
+	w.close.Do(func() {
+		close(w.events)
+	})
+	w.wg.Wait()
+}
+
+func (w *Writer) work() {
+	defer w.wg.Done()
+
+	for ev := range w.events {
+		batch := []middleware.Event{ev}
+	fill:
+		for len(batch) < maxBatch {
+			select {
+			case ev, ok := <-w.events:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, ev)
+			default:
+				break fill
+			}
+		}
+
+		w.save(batch)
+	}
+}
+
+func (w *Writer) save(events []middleware.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), saveTimeout)
+	defer cancel()
+
+	if err := Record(ctx, w.db, events...); err != nil {
+		slog.ErrorContext(ctx, "saving audit events", "count", len(events), "error", err)
+	}
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+func TestWriter_BackPressure(t *testing.T) {
+	db := &fakeExecer{release: make(chan struct{})}
+	w := NewWriter(db, 1)
+
+	ev := middleware.Event{Table: "books", Action: middleware.ActionCreate}
+
+	// The worker takes the first event and gets stuck saving it, the second
+	// fills the queue.
+	assert.NoError(t, w.Write(context.Background(), ev))
+	assert.Eventually(t, func() bool { return len(w.events) == 0 }, time.Second, time.Millisecond)
+	assert.NoError(t, w.Write(context.Background(), ev))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, w.Write(ctx, ev), context.DeadlineExceeded, "expected to wait for room instead of dropping")
+
+	close(db.release)
+	w.Close()
+
+	assert.Len(t, inserts(db.saved()), 2, "expected queued events to be saved on close")
+}
@@ -1,59 +1,177 @@
 package authentication
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/alexedwards/argon2id"
 	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
 
+	"github.com/gmhafiz/go8/config"
+	"github.com/gmhafiz/go8/ent/gen"
 	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/filter"
 	"github.com/gmhafiz/go8/internal/utility/param"
 	"github.com/gmhafiz/go8/internal/utility/request"
 	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/internal/utility/validate"
+	"github.com/gmhafiz/go8/third_party/mail"
+	"github.com/gmhafiz/go8/third_party/password"
+	validateLib "github.com/gmhafiz/go8/third_party/validate"
 )
 
 const (
 	minPasswordLength = 13
+
+	// reauthenticationWindow is how long after logging in a user without a
+	// password may perform sensitive actions.
+	reauthenticationWindow = 5 * time.Minute
 )
 
 var (
 	ErrEmailRequired  = errors.New("email is required")
 	ErrPasswordLength = fmt.Errorf("password must be at least %d characters", minPasswordLength)
+
+	ErrTokenNameRequired = errors.New("token name is required")
+	ErrTokenScopes       = fmt.Errorf("token scopes must be one or more of %v", tokenScopes)
+	ErrTokenExpiry       = errors.New("token expiry must be in the future")
+	ErrTokenFromToken    = errors.New("tokens can only be created when logged in, not with another token")
+
+	ErrAccountDisabled = errors.New("account is disabled")
+
+	ErrInvitationRequired = errors.New("an invitation code is required to register")
+	ErrInvitationRoles    = fmt.Errorf("invitation roles must be among %v and held by yourself", invitationRoles)
+	ErrInvitationMaxUses  = errors.New("invitation max uses must be at least 1")
+	ErrInvitationExpiry   = errors.New("invitation expiry must be in the future")
+
+	ErrWrongPassword           = errors.New("current password is incorrect")
+	ErrReauthenticationNeeded  = errors.New("please log in again to continue")
+	ErrVerificationTokenNeeded = errors.New("verification token is required")
 )
 
+// magicLinkCookie holds a nonce binding a sign-in link to the browser that
+// requested it.
+const magicLinkCookie = "magic_link_nonce"
+
+var tokenScopes = []string{middleware.ScopeRead, middleware.ScopeWrite}
+
+// invitationRoles are roles that can be given to invited users.
+var invitationRoles = []string{middleware.RoleAdmin, middleware.RoleInviter}
+
+const invitationLifetime = 7 * 24 * time.Hour
+
 type Handler struct {
-	repo    Repo
-	session *scs.SessionManager
+	repo      Repo
+	session   *scs.SessionManager
+	mailer    mail.Mailer
+	notifier  mail.Mailer
+	logins    *Logins
+	passwords *password.Hasher
+	publicURL string
+	magicLink config.MagicLink
+
+	registration config.Registration
+	validate     *validator.Validate
+	// loginLimit rate limits the routes that log a user in.
+	loginLimit func(http.Handler) http.Handler
+}
+
+type Option func(h *Handler)
+
+// WithMailer sets how verification emails are sent. Defaults to logging them.
+func WithMailer(mailer mail.Mailer) Option {
+	return func(h *Handler) {
+		h.mailer = mailer
+	}
+}
+
+// WithNotifier sets how security alerts, such as a login from a new device,
+// are sent. Defaults to the mailer. A mail.Queue keeps logins from waiting on
+// the mail server.
+func WithNotifier(notifier mail.Mailer) Option {
+	return func(h *Handler) {
+		h.notifier = notifier
+	}
+}
+
+// WithPasswordHasher sets how passwords are hashed. Defaults to argon2id
+// parameters read from PASSWORD_* environment variables.
+func WithPasswordHasher(passwords *password.Hasher) Option {
+	return func(h *Handler) {
+		h.passwords = passwords
+	}
+}
+
+// WithMagicLink enables passwordless login through a link sent by email.
+func WithMagicLink(cfg config.MagicLink) Option {
+	return func(h *Handler) {
+		h.magicLink = cfg
+	}
+}
+
+// WithRegistration sets whether registering needs an invitation.
+func WithRegistration(cfg config.Registration) Option {
+	return func(h *Handler) {
+		h.registration = cfg
+	}
+}
+
+// WithLoginRateLimit limits login attempts, including magic links, by client
+// address. Not limited by default.
+func WithLoginRateLimit(limiter middleware.Limiter, p middleware.Policy) Option {
+	return func(h *Handler) {
+		h.loginLimit = middleware.RateLimit(limiter, p, middleware.KeyByIP)
+	}
+}
+
+// WithPublicURL sets the base URL of links sent in emails.
+func WithPublicURL(publicURL string) Option {
+	return func(h *Handler) {
+		h.publicURL = strings.TrimSuffix(publicURL, "/")
+	}
 }
 
 func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
-	err := request.DecodeJSON(w, r, &req)
+	err := request.Decode(w, r, &req)
 	if err != nil {
-		respond.Error(w, http.StatusBadRequest, nil)
+		respond.Error(w, http.StatusBadRequest, err)
 		return
 	}
 
-	if req.Email == "" {
-		respond.Error(w, http.StatusBadRequest, ErrEmailRequired)
+	if errs := validate.Validate(h.validate, r, req); errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
 		return
 	}
 
-	if len(req.Password) < minPasswordLength {
-		respond.Error(w, http.StatusBadRequest, ErrPasswordLength)
+	if h.registration.InviteOnly && req.InvitationCode == "" {
+		respond.Error(w, http.StatusBadRequest, ErrInvitationRequired)
 		return
 	}
 
-	hashedPassword, err := argon2id.CreateHash(req.Password, argon2id.DefaultParams)
+	hashedPassword, err := h.passwords.Hash(r.Context(), req.Password)
 	if err != nil {
-		respond.Error(w, http.StatusInternalServerError, nil)
+		respondHashError(w, err)
 		return
 	}
 
-	if err := h.repo.Register(r.Context(), req.FirstName, req.LastName, req.Email, hashedPassword); err != nil {
-		respond.Error(w, http.StatusBadRequest, err)
+	if err := h.repo.Register(r.Context(), req.FirstName, req.LastName, req.Email, hashedPassword, req.InvitationCode); err != nil {
+		if errors.Is(err, ErrEmailNotAvailable) || errors.Is(err, ErrInvitationInvalid) {
+			respond.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -62,26 +180,160 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
-	err := request.DecodeJSON(w, r, &req)
+	err := request.Decode(w, r, &req)
 	if err != nil {
-		respond.Error(w, http.StatusBadRequest, nil)
+		respond.Error(w, http.StatusBadRequest, err)
 		return
 	}
 
 	ctx := r.Context()
 
-	user, _, err := h.repo.Login(ctx, req)
+	user, err := h.repo.UserByEmail(ctx, req.Email)
 	if err != nil {
+		if gen.IsNotFound(err) {
+			h.logins.record(ctx, nil, EventLoginFailed, MethodPassword, ReasonUnknownEmail)
+		}
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	match, needsRehash, err := h.passwords.Verify(ctx, req.Password, user.Password)
+	if errors.Is(err, password.ErrBusy) || isContextError(err) {
+		respondHashError(w, err)
+		return
+	}
+	if err != nil || !match {
+		h.logins.record(ctx, &user.ID, EventLoginFailed, MethodPassword, ReasonWrongPassword)
 		respond.Status(w, http.StatusUnauthorized)
 		return
 	}
 
+	// Only told after a correct password so that it does not reveal the
+	// account exists.
+	if user.DisabledAt != nil {
+		h.logins.record(ctx, &user.ID, EventLoginFailed, MethodPassword, ReasonDisabled)
+		respond.Error(w, http.StatusForbidden, ErrAccountDisabled)
+		return
+	}
+
+	// Plain text password is only known now, so this is the only chance to
+	// upgrade an outdated or imported hash. Failing to do so does not stop
+	// the user from logging in.
+	if needsRehash {
+		if hashedPassword, err := h.passwords.Hash(ctx, req.Password); err == nil {
+			if err := h.repo.ChangePassword(ctx, user.ID, hashedPassword); err != nil {
+				slog.WarnContext(ctx, "upgrading password hash", "error", err)
+			}
+		}
+	}
+
 	if err := h.session.RenewToken(ctx); err != nil {
 		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
+	h.session.RememberMe(ctx, req.RememberMe)
 	h.session.Put(ctx, string(middleware.KeyID), user.ID)
+	h.session.Put(ctx, string(middleware.KeyAuthTime), time.Now().Unix())
+	h.logins.LoggedIn(ctx, user.ID, MethodPassword)
+
+	respond.Status(w, http.StatusOK)
+}
+
+// RequestMagicLink emails a single-use sign-in link. It responds the same way
+// whether the email is registered or not.
+func (h *Handler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var req MagicLinkRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Email == "" {
+		respond.Error(w, http.StatusBadRequest, ErrEmailRequired)
+		return
+	}
+
+	ctx := r.Context()
+
+	nonce, err := generateToken()
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	token, err := h.repo.CreateMagicLink(ctx, req.Email, nonce, h.magicLink.Lifetime)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if token != "" {
+		if err := h.sendMagicLink(ctx, req.Email, token); err != nil {
+			respond.Error(w, http.StatusInternalServerError, fmt.Errorf("sending magic link: %w", err))
+			return
+		}
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     magicLinkCookie,
+		Value:    nonce,
+		Path:     "/api/v1/login/magic",
+		MaxAge:   int(h.magicLink.Lifetime.Seconds()),
+		Secure:   h.session.Cookie.Secure,
+		HttpOnly: true,
+		// Lax so that the cookie is sent when the link is opened from an
+		// email client.
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	respond.Status(w, http.StatusAccepted)
+}
+
+// ConsumeMagicLink logs in the user a sign-in link was sent to. The link must be
+// opened in the same browser it was requested from.
+func (h *Handler) ConsumeMagicLink(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	nonce, err := r.Cookie(magicLinkCookie)
+	if token == "" || err != nil {
+		respond.Error(w, http.StatusBadRequest, ErrMagicLinkInvalid)
+		return
+	}
+
+	ctx := r.Context()
+
+	userID, err := h.repo.ConsumeMagicLink(ctx, token, nonce.Value)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrMagicLinkInvalid):
+			respond.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, ErrAccountDisabled):
+			respond.Error(w, http.StatusForbidden, err)
+		default:
+			respond.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if err := h.session.RenewToken(ctx); err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	h.session.Put(ctx, string(middleware.KeyID), userID)
+	h.session.Put(ctx, string(middleware.KeyAuthTime), time.Now().Unix())
+	h.logins.LoggedIn(ctx, userID, MethodMagicLink)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:   magicLinkCookie,
+		Path:   "/api/v1/login/magic",
+		MaxAge: -1,
+	})
+
+	if h.magicLink.PostLoginRedirect != "" {
+		http.Redirect(w, r, h.magicLink.PostLoginRedirect, http.StatusSeeOther)
+		return
+	}
 
 	respond.Status(w, http.StatusOK)
 }
@@ -91,43 +343,47 @@ func (h *Handler) Protected(w http.ResponseWriter, _ *http.Request) {
 }
 
 func (h *Handler) Me(w http.ResponseWriter, r *http.Request) {
-	userID := h.session.Get(r.Context(), string(middleware.KeyID))
+	userID, _ := middleware.UserID(r.Context())
 
 	respond.Json(w, http.StatusOK, map[string]any{"user_id": userID})
 }
 
 func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
-	err := h.session.Destroy(r.Context())
+	ctx := r.Context()
+	userID, loggedIn := h.session.Get(ctx, string(middleware.KeyID)).(uint64)
+
+	err := h.session.Destroy(ctx)
 	if err != nil {
 		respond.Status(w, http.StatusBadRequest)
 		return
 	}
+
+	if loggedIn {
+		h.logins.record(ctx, &userID, EventLogout, "", "")
+	}
 }
 
 func (h *Handler) ForceLogout(w http.ResponseWriter, r *http.Request) {
-	// Authorization is needed to ensure that only super admin can force delete
-	currUser := h.session.Get(r.Context(), string(middleware.KeyID))
-	// A more robust authorization is needed for real-world implementation.
-	// For now, we naively check if user id is equal to 1.
-	if currUser.(uint64) != 1 {
-		respond.Status(w, http.StatusInternalServerError)
+	// Only an admin can force other users to log out.
+	if !middleware.HasRole(r.Context(), middleware.RoleAdmin) {
+		respond.Status(w, http.StatusForbidden)
 		return
 	}
 
 	userID, err := param.UInt64(r, "userID")
 	if err != nil {
-		respond.Status(w, http.StatusInternalServerError)
+		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	ok, err := h.repo.Logout(r.Context(), userID)
 	if err != nil {
-		respond.Status(w, http.StatusInternalServerError)
+		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	if !ok {
-		respond.Json(w, http.StatusInternalServerError, map[string]string{"message": "unable to log out"})
+		respond.Status(w, http.StatusInternalServerError)
 	}
 }
 
@@ -136,7 +392,7 @@ func (h *Handler) ForceLogout(w http.ResponseWriter, r *http.Request) {
 // this csrf token needs to be attached along in the HTML along.
 // Then check in this API for its existence.
 func (h *Handler) Csrf(w http.ResponseWriter, r *http.Request) {
-	_, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	_, ok := middleware.UserID(r.Context())
 	if !ok {
 		respond.Error(w, http.StatusBadRequest, errors.New("you need to be logged in"))
 		return
@@ -144,16 +400,668 @@ func (h *Handler) Csrf(w http.ResponseWriter, r *http.Request) {
 
 	token, err := h.repo.Csrf(r.Context())
 	if err != nil {
-		respond.Status(w, http.StatusInternalServerError)
+		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	respond.Json(w, http.StatusOK, &RespondCsrf{CsrfToken: token})
 }
 
-func NewHandler(session *scs.SessionManager, repo Repo) *Handler {
-	return &Handler{
-		repo:    repo,
-		session: session,
+// CreateToken creates a named personal access token for scripts and CI jobs. The
+// token is sent as `Authorization: Bearer <token>` header. It is only shown once.
+// A token cannot create another, or a leaked one could be swapped for a token
+// that never expires.
+func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+	if middleware.ViaToken(r.Context()) {
+		respond.Error(w, http.StatusForbidden, ErrTokenFromToken)
+		return
+	}
+
+	var req CreateTokenRequest
+	err := request.Decode(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Name == "" {
+		respond.Error(w, http.StatusBadRequest, ErrTokenNameRequired)
+		return
+	}
+	if len(req.Scopes) == 0 || slices.ContainsFunc(req.Scopes, func(scope string) bool {
+		return !slices.Contains(tokenScopes, scope)
+	}) {
+		respond.Error(w, http.StatusBadRequest, ErrTokenScopes)
+		return
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		respond.Error(w, http.StatusBadRequest, ErrTokenExpiry)
+		return
+	}
+
+	created, token, err := h.repo.CreateToken(r.Context(), userID, req)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, CreatedTokenResponse{
+		TokenResponse: TokenResource(created),
+		Token:         token,
+	})
+}
+
+func (h *Handler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	tokens, err := h.repo.ListTokens(r.Context(), userID)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, TokenResources(tokens))
+}
+
+func (h *Handler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	tokenID, err := param.UInt64(r, "tokenID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.repo.DeleteToken(r.Context(), userID, tokenID)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
 	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// CreateInvitation creates an invitation code. The plain code is only shown in
+// this response. Admins may give any of invitationRoles while inviters may only
+// give roles they have themselves.
+func (h *Handler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+	if !canInvite(ctx) {
+		respond.Status(w, http.StatusForbidden)
+		return
+	}
+
+	var req CreateInvitationRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Roles == nil {
+		req.Roles = []string{}
+	}
+	if slices.ContainsFunc(req.Roles, func(role string) bool {
+		return !slices.Contains(invitationRoles, role) || !middleware.HasRole(ctx, role) && !middleware.HasRole(ctx, middleware.RoleAdmin)
+	}) {
+		respond.Error(w, http.StatusBadRequest, ErrInvitationRoles)
+		return
+	}
+	if req.MaxUses == 0 {
+		req.MaxUses = 1
+	}
+	if req.MaxUses < 1 {
+		respond.Error(w, http.StatusBadRequest, ErrInvitationMaxUses)
+		return
+	}
+	if req.ExpiresAt == nil {
+		expiresAt := time.Now().Add(invitationLifetime)
+		req.ExpiresAt = &expiresAt
+	}
+	if !req.ExpiresAt.After(time.Now()) {
+		respond.Error(w, http.StatusBadRequest, ErrInvitationExpiry)
+		return
+	}
+
+	created, code, err := h.repo.CreateInvitation(ctx, userID, req)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, CreatedInvitationResponse{
+		InvitationResponse: InvitationResource(Invitation{Invitation: created}),
+		Code:               code,
+	})
+}
+
+// ListInvitations lists invitations along with who used them. Admins see all
+// invitations, others only their own.
+func (h *Handler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	createdBy, ok := invitationOwner(ctx)
+	if !ok {
+		respond.Status(w, http.StatusForbidden)
+		return
+	}
+
+	invitations, err := h.repo.ListInvitations(ctx, createdBy)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, InvitationResources(invitations))
+}
+
+func (h *Handler) GetInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	createdBy, ok := invitationOwner(ctx)
+	if !ok {
+		respond.Status(w, http.StatusForbidden)
+		return
+	}
+
+	invitationID, err := param.UInt64(r, "invitationID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	found, err := h.repo.ReadInvitation(ctx, createdBy, invitationID)
+	if err != nil {
+		if errors.Is(err, ErrInvitationNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, InvitationResource(*found))
+}
+
+// RevokeInvitation stops an invitation from being used. Users who already
+// registered with it are not affected.
+func (h *Handler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	createdBy, ok := invitationOwner(ctx)
+	if !ok {
+		respond.Status(w, http.StatusForbidden)
+		return
+	}
+
+	invitationID, err := param.UInt64(r, "invitationID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.repo.RevokeInvitation(ctx, createdBy, invitationID)
+	if err != nil {
+		if errors.Is(err, ErrInvitationNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+func canInvite(ctx context.Context) bool {
+	return middleware.HasRole(ctx, middleware.RoleAdmin) || middleware.HasRole(ctx, middleware.RoleInviter)
+}
+
+// invitationOwner returns whose invitations the current user may manage, which
+// is 0 for everyone's in case of an admin.
+func invitationOwner(ctx context.Context) (uint64, bool) {
+	userID, ok := middleware.UserID(ctx)
+	if !ok || !canInvite(ctx) {
+		return 0, false
+	}
+	if middleware.HasRole(ctx, middleware.RoleAdmin) {
+		return 0, true
+	}
+	return userID, true
+}
+
+// ListSessions lists devices the current user is logged in from. The session
+// making this request is flagged as current.
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	sessions, err := h.repo.ListSessions(ctx, userID, h.session.Token(ctx))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, sessions)
+}
+
+// RevokeSession logs out one of the current user's own sessions.
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	err := h.repo.RevokeSession(ctx, userID, chi.URLParam(r, "sessionID"))
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// RevokeOtherSessions logs out everywhere else except the session making this
+// request.
+func (h *Handler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	deleted, err := h.repo.RevokeOtherSessions(ctx, userID, h.session.Token(ctx))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, map[string]int64{"revoked": deleted})
+}
+
+// Profile returns the current user's own profile.
+func (h *Handler) Profile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	profile, err := h.repo.Profile(ctx, userID)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, ProfileResource(profile))
+}
+
+// UpdateProfile partially updates the current user's profile. A new email is
+// only applied after it is verified through a link sent to that address.
+// Changing password requires current password and logs out other sessions.
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req UpdateProfileRequest
+	err := request.Decode(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Email != nil && *req.Email == "" {
+		respond.Error(w, http.StatusBadRequest, ErrEmailRequired)
+		return
+	}
+	if req.Password != nil && len(*req.Password) < minPasswordLength {
+		respond.Error(w, http.StatusBadRequest, ErrPasswordLength)
+		return
+	}
+
+	profile, err := h.repo.Profile(ctx, userID)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Check the new email and hash before saving anything so that neither an
+	// email in use nor a busy hashing pool leaves the profile half updated.
+	changeEmail := req.Email != nil && !strings.EqualFold(*req.Email, profile.User.Email)
+	if changeEmail {
+		taken, err := h.repo.EmailTaken(ctx, *req.Email)
+		if err != nil {
+			respond.Error(w, http.StatusInternalServerError, err)
+			return
+		}
+		if taken {
+			respond.Error(w, http.StatusBadRequest, ErrEmailNotAvailable)
+			return
+		}
+	}
+
+	var hashedPassword string
+	if req.Password != nil {
+		if err := h.reauthenticate(ctx, profile, req.CurrentPassword); err != nil {
+			respondReauthenticationError(w, err)
+			return
+		}
+		hashedPassword, err = h.passwords.Hash(ctx, *req.Password)
+		if err != nil {
+			respondHashError(w, err)
+			return
+		}
+	}
+
+	if err := h.repo.UpdateProfile(ctx, userID, req); err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if req.Password != nil {
+		if err := h.repo.ChangePassword(ctx, userID, hashedPassword); err != nil {
+			respond.Error(w, http.StatusInternalServerError, err)
+			return
+		}
+		_, err = h.repo.RevokeOtherSessions(ctx, userID, h.session.Token(ctx))
+		if err != nil && !errors.Is(err, ErrSessionsNotSupported) {
+			respond.Error(w, http.StatusInternalServerError, err)
+			return
+		}
+		h.logins.record(ctx, &userID, EventPasswordChanged, "", "")
+	}
+
+	if changeEmail {
+		token, err := h.repo.RequestEmailChange(ctx, userID, *req.Email)
+		if err != nil {
+			if errors.Is(err, ErrEmailNotAvailable) {
+				respond.Error(w, http.StatusBadRequest, err)
+				return
+			}
+			respond.Error(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		if err := h.sendEmailVerification(ctx, *req.Email, token); err != nil {
+			respond.Error(w, http.StatusInternalServerError, fmt.Errorf("sending email verification: %w", err))
+			return
+		}
+	}
+
+	profile, err = h.repo.Profile(ctx, userID)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, ProfileResource(profile))
+}
+
+// VerifyEmail is the link sent to a new email address. It does not require
+// being logged in because it may be opened on another device.
+func (h *Handler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		respond.Error(w, http.StatusBadRequest, ErrVerificationTokenNeeded)
+		return
+	}
+
+	err := h.repo.VerifyEmail(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, ErrVerificationInvalid) || errors.Is(err, ErrEmailNotAvailable) {
+			respond.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Status(w, http.StatusOK)
+}
+
+// DeleteAccount permanently deletes the current user along with everything tied
+// to them. The current password must be given again.
+func (h *Handler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req DeleteAccountRequest
+	err := request.Decode(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	profile, err := h.repo.Profile(ctx, userID)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := h.reauthenticate(ctx, profile, req.Password); err != nil {
+		respondReauthenticationError(w, err)
+		return
+	}
+
+	if err := h.repo.DeleteAccount(ctx, userID); err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Session row is already gone, but the cookie still needs clearing.
+	if h.session.Token(ctx) != "" {
+		_ = h.session.Destroy(ctx)
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// Export returns everything stored about the current user as a downloadable
+// JSON document.
+func (h *Handler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	export, err := h.repo.Export(ctx, userID, h.session.Token(ctx))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="export-%d.json"`, userID))
+	respond.Json(w, http.StatusOK, ExportResource(export))
+}
+
+// ListLoginEvents lists the current user's logins, failed logins, logouts and
+// password changes, newest first.
+func (h *Handler) ListLoginEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := middleware.UserID(ctx)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	events, total, err := h.repo.ListLoginEvents(ctx, userID, filter.New(r.URL.Query()))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, respond.Standard{
+		Data: LoginEventResources(events),
+		Meta: respond.Meta{
+			Size:  len(events),
+			Total: total,
+		},
+	})
+}
+
+// reauthenticate checks that the person behind this request is the account
+// owner. Accounts without a password, such as those provisioned through
+// OpenID Connect, must have logged in recently instead.
+func (h *Handler) reauthenticate(ctx context.Context, profile *Profile, currentPassword string) error {
+	if profile.User.Password == "" {
+		authTime, _ := h.session.Get(ctx, string(middleware.KeyAuthTime)).(int64)
+		if time.Since(time.Unix(authTime, 0)) > reauthenticationWindow {
+			return ErrReauthenticationNeeded
+		}
+		return nil
+	}
+
+	match, _, err := h.passwords.Verify(ctx, currentPassword, profile.User.Password)
+	if errors.Is(err, password.ErrBusy) || isContextError(err) {
+		return err
+	}
+	if err != nil || !match {
+		return ErrWrongPassword
+	}
+
+	return nil
+}
+
+func respondReauthenticationError(w http.ResponseWriter, err error) {
+	if errors.Is(err, password.ErrBusy) || isContextError(err) {
+		respondHashError(w, err)
+		return
+	}
+	respond.Error(w, http.StatusForbidden, err)
+}
+
+// respondHashError asks the client to retry later when the hashing pool is
+// full or the request ran out of time waiting for it, instead of treating it
+// as a server fault or a wrong password.
+func respondHashError(w http.ResponseWriter, err error) {
+	if errors.Is(err, password.ErrBusy) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(password.RetryAfter.Seconds())))
+		respond.Error(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	if isContextError(err) {
+		respond.Error(w, http.StatusServiceUnavailable, middleware.ErrRequestTimeout)
+		return
+	}
+	respond.Error(w, http.StatusInternalServerError, nil)
+}
+
+// isContextError reports whether err comes from the request being cancelled
+// or running past its deadline rather than from the work itself.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (h *Handler) sendEmailVerification(ctx context.Context, email, token string) error {
+	link := h.publicURL + "/api/v1/email/verify?token=" + url.QueryEscape(token)
+
+	return h.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Verify your new email address",
+		Body: fmt.Sprintf("Open the following link to verify your new email address:\n\n%s\n\n"+
+			"This link expires in %s. If you did not request this change, you can ignore this email.", link, emailVerificationLifetime),
+	})
+}
+
+func (h *Handler) sendMagicLink(ctx context.Context, email, token string) error {
+	link := h.publicURL + "/api/v1/login/magic/verify?token=" + url.QueryEscape(token)
+
+	return h.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf("Open the following link in the same browser to sign in:\n\n%s\n\n"+
+			"This link expires in %s and can only be used once. If you did not ask to sign in, you can ignore this email.", link, h.magicLink.Lifetime),
+	})
+}
+
+// network returns the /24 prefix of an IPv4 address or the /48 prefix of an
+// IPv6 address, which is roughly what a single home or office network uses.
+// ip may carry a port or be a X-Forwarded-For list, of which the first address
+// is the client.
+func network(ip string) string {
+	ip, _, _ = strings.Cut(ip, ",")
+	ip = strings.TrimSpace(ip)
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ""
+	}
+
+	return prefix.String()
+}
+
+func NewHandler(session *scs.SessionManager, repo Repo, opts ...Option) *Handler {
+	h := &Handler{
+		repo:      repo,
+		session:   session,
+		mailer:    &mail.Log{},
+		publicURL: "http://localhost:3080",
+		magicLink: config.NewMagicLink(),
+		validate:  validateLib.New(validateLib.WithEmailTaken(repo.EmailTaken)),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if h.notifier == nil {
+		h.notifier = h.mailer
+	}
+	h.logins = NewLogins(h.repo, h.notifier)
+	if h.passwords == nil {
+		h.passwords = password.New(config.NewPassword())
+	}
+
+	return h
 }
@@ -25,10 +25,18 @@ import (
 	"github.com/ory/dockertest/v3"
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 
+	"github.com/gmhafiz/go8/config"
 	"github.com/gmhafiz/go8/database"
 	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/user"
 	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/filter"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+	"github.com/gmhafiz/go8/internal/utility/validate"
+	"github.com/gmhafiz/go8/third_party/mail"
+	"github.com/gmhafiz/go8/third_party/password"
 	"github.com/gmhafiz/go8/third_party/postgresstore"
 )
 
@@ -40,6 +48,8 @@ const (
 
 var (
 	migrator *database.Migrate
+
+	hasher, _ = tokenhash.New("secret")
 )
 
 func TestMain(m *testing.M) {
@@ -102,7 +112,7 @@ func TestMain(m *testing.M) {
 		log.Fatalln(err)
 	}
 	_, err = migrator.DB.ExecContext(context.Background(), `
-		INSERT INTO users (email, password) VALUES ($1, $2)
+		INSERT INTO users (email, password, roles) VALUES ($1, $2, '["admin"]')
 		ON CONFLICT (email) DO NOTHING 
 		`, "admin@gmhafiz.com", hashedPassword)
 	if err != nil {
@@ -131,6 +141,9 @@ func TestHandler_RegisterIntegration(t *testing.T) {
 	}
 	type want struct {
 		error
+		// field fails rule when the request is invalid.
+		field  string
+		rule   string
 		status int
 	}
 	tests := []struct {
@@ -160,7 +173,8 @@ func TestHandler_RegisterIntegration(t *testing.T) {
 				},
 			},
 			want: want{
-				error:  ErrEmailNotAvailable,
+				field:  "email",
+				rule:   "unique_email",
 				status: http.StatusBadRequest,
 			},
 		},
@@ -173,7 +187,8 @@ func TestHandler_RegisterIntegration(t *testing.T) {
 				},
 			},
 			want: want{
-				error:  ErrEmailRequired,
+				field:  "email",
+				rule:   "required",
 				status: http.StatusBadRequest,
 			},
 		},
@@ -186,7 +201,8 @@ func TestHandler_RegisterIntegration(t *testing.T) {
 				},
 			},
 			want: want{
-				error:  ErrPasswordLength,
+				field:  "password",
+				rule:   "min",
 				status: http.StatusBadRequest,
 			},
 		},
@@ -222,15 +238,23 @@ func TestHandler_RegisterIntegration(t *testing.T) {
 
 			if len(b) > 0 {
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string                `json:"detail"`
+					Errors []validate.FieldError `json:"errors"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
 
-				assert.Equal(t, tt.want.error.Error(), errStruct.Message)
+				if tt.want.rule != "" {
+					if assert.Len(t, errStruct.Errors, 1) {
+						assert.Equal(t, tt.want.field, errStruct.Errors[0].Field)
+						assert.Equal(t, tt.want.rule, errStruct.Errors[0].Rule)
+					}
+					return
+				}
+				assert.Equal(t, tt.want.error.Error(), errStruct.Detail)
 			}
 		})
 	}
@@ -272,6 +296,42 @@ func TestHandler_LoginIntegration(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "wrong password",
+			args: args{
+				LoginRequest: &LoginRequest{
+					Email:    "email@example.com",
+					Password: "wrongPassword",
+				},
+			},
+			want: want{
+				error:  nil,
+				status: http.StatusUnauthorized,
+				token: struct {
+					Token string
+				}{
+					Token: "",
+				},
+			},
+		},
+		{
+			name: "disabled",
+			args: args{
+				LoginRequest: &LoginRequest{
+					Email:    "disabled@example.com",
+					Password: "highEntropyPassword",
+				},
+			},
+			want: want{
+				error:  nil,
+				status: http.StatusForbidden,
+				token: struct {
+					Token string
+				}{
+					Token: "",
+				},
+			},
+		},
 		{
 			name: "not registered",
 			args: args{
@@ -305,6 +365,12 @@ func TestHandler_LoginIntegration(t *testing.T) {
 		`, "email@example.com", hashedPassword)
 	assert.Nil(t, err)
 
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password, disabled_at) VALUES ($1, $2, current_timestamp)
+		ON CONFLICT (email) DO NOTHING 
+		`, "disabled@example.com", hashedPassword)
+	assert.Nil(t, err)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
@@ -841,16 +907,16 @@ func TestHandler_Csrf_Valid_TokenIntegration(t *testing.T) {
 
 			assert.NotNil(t, resp.CsrfToken)
 
-			validity := csrf.ValidToken(context.Background(), migrator.DB, resp.CsrfToken)
+			validity := csrf.ValidToken(context.Background(), migrator.DB, hasher, resp.CsrfToken)
 			assert.Equal(t, tt.want.csrfTokenValidity, validity)
 
 			// csrf token does not get deleted yet
-			validity = csrf.ValidToken(context.Background(), migrator.DB, resp.CsrfToken)
+			validity = csrf.ValidToken(context.Background(), migrator.DB, hasher, resp.CsrfToken)
 			assert.Equal(t, tt.want.csrfTokenValidity, validity)
 
 			time.Sleep(101 * time.Millisecond)
 
-			validity = csrf.ValidToken(context.Background(), migrator.DB, resp.CsrfToken)
+			validity = csrf.ValidToken(context.Background(), migrator.DB, hasher, resp.CsrfToken)
 			assert.Equal(t, false, validity)
 		})
 	}
@@ -950,16 +1016,851 @@ func TestHandler_Csrf_Valid_And_Delete_TokenIntegration(t *testing.T) {
 
 			assert.NotNil(t, resp.CsrfToken)
 
-			err = csrf.ValidAndDeleteToken(context.Background(), migrator.DB, resp.CsrfToken)
+			err = csrf.ValidAndDeleteToken(context.Background(), migrator.DB, hasher, resp.CsrfToken)
 			assert.Nil(t, err)
 
 			// at this point, the csrf token would have been deleted
-			err = csrf.ValidAndDeleteToken(context.Background(), migrator.DB, resp.CsrfToken)
+			err = csrf.ValidAndDeleteToken(context.Background(), migrator.DB, hasher, resp.CsrfToken)
 			assert.NotNil(t, err)
 		})
 	}
 }
 
+func TestHandler_Personal_Access_TokenIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	type args struct {
+		*LoginRequest
+		*CreateTokenRequest
+		method string
+		path   string
+	}
+	type want struct {
+		createStatus int
+		useStatus    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "read token on safe method",
+			args: args{
+				LoginRequest: &LoginRequest{
+					Email:    "email@example.com",
+					Password: "highEntropyPassword",
+				},
+				CreateTokenRequest: &CreateTokenRequest{
+					Name:   "ci",
+					Scopes: []string{middleware.ScopeRead},
+				},
+				method: http.MethodGet,
+				path:   "/api/v1/restricted/me",
+			},
+			want: want{
+				createStatus: http.StatusCreated,
+				useStatus:    http.StatusOK,
+			},
+		},
+		{
+			name: "read token on modifying method",
+			args: args{
+				LoginRequest: &LoginRequest{
+					Email:    "email@example.com",
+					Password: "highEntropyPassword",
+				},
+				CreateTokenRequest: &CreateTokenRequest{
+					Name:   "ci",
+					Scopes: []string{middleware.ScopeRead},
+				},
+				method: http.MethodPost,
+				path:   "/api/v1/restricted/logout/2",
+			},
+			want: want{
+				createStatus: http.StatusCreated,
+				useStatus:    http.StatusForbidden,
+			},
+		},
+		{
+			name: "write token creating another token",
+			args: args{
+				LoginRequest: &LoginRequest{
+					Email:    "email@example.com",
+					Password: "highEntropyPassword",
+				},
+				CreateTokenRequest: &CreateTokenRequest{
+					Name:   "ci",
+					Scopes: []string{middleware.ScopeWrite},
+				},
+				method: http.MethodPost,
+				path:   "/api/v1/tokens",
+			},
+			want: want{
+				createStatus: http.StatusCreated,
+				useStatus:    http.StatusForbidden,
+			},
+		},
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+
+	hashedPassword, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING 
+		`, "email@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := json.NewEncoder(&buf).Encode(tt.args.LoginRequest)
+			assert.Nil(t, err)
+
+			rr := httptest.NewRequest(http.MethodPost, "/api/v1/login", &buf)
+			ww := httptest.NewRecorder()
+
+			router := chi.NewRouter()
+			router.Use(middleware.LoadAndSave(session))
+
+			RegisterHTTPEndPoints(router, session, repo)
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, http.StatusOK, ww.Code)
+			cookie, err := extractToken(ww.Header().Get("Set-Cookie"))
+			assert.Nil(t, err)
+
+			err = json.NewEncoder(&buf).Encode(tt.args.CreateTokenRequest)
+			assert.Nil(t, err)
+
+			rr = httptest.NewRequest(http.MethodPost, "/api/v1/tokens", &buf)
+			ww = httptest.NewRecorder()
+			rr.AddCookie(&http.Cookie{
+				Name:  sessionName,
+				Value: cookie,
+			})
+
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, tt.want.createStatus, ww.Code)
+
+			var created CreatedTokenResponse
+			err = json.NewDecoder(ww.Body).Decode(&created)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, created.Token)
+
+			// Bearer token is used instead of session cookie
+			rr = httptest.NewRequest(tt.args.method, tt.args.path, nil)
+			ww = httptest.NewRecorder()
+			rr.Header.Set("Authorization", "Bearer "+created.Token)
+
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, tt.want.useStatus, ww.Code)
+
+			tokens, err := repo.ListTokens(context.Background(), 2)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, tokens)
+			if tt.want.useStatus == http.StatusOK {
+				assert.NotNil(t, tokens[0].LastUsedAt)
+			}
+
+			exists, err := repo.TokenExists(context.Background(), created.Token)
+			assert.Nil(t, err)
+			assert.True(t, exists)
+
+			// Revoked token can no longer be used
+			err = repo.DeleteToken(context.Background(), 2, created.ID)
+			assert.Nil(t, err)
+
+			exists, err = repo.TokenExists(context.Background(), created.Token)
+			assert.Nil(t, err)
+			assert.False(t, exists)
+
+			rr = httptest.NewRequest(http.MethodGet, "/api/v1/restricted/me", nil)
+			ww = httptest.NewRecorder()
+			rr.Header.Set("Authorization", "Bearer "+created.Token)
+
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, http.StatusUnauthorized, ww.Code)
+		})
+	}
+}
+
+func TestHandler_SessionsIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+
+	hashedPassword, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING 
+		`, "email@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo)
+
+	login := func(userAgent string) string {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(&LoginRequest{
+			Email:    "email@example.com",
+			Password: "highEntropyPassword",
+		})
+		assert.Nil(t, err)
+
+		rr := httptest.NewRequest(http.MethodPost, "/api/v1/login", &buf)
+		rr.Header.Set("User-Agent", userAgent)
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, rr)
+		assert.Equal(t, http.StatusOK, ww.Code)
+
+		token, err := extractToken(ww.Header().Get("Set-Cookie"))
+		assert.Nil(t, err)
+		return token
+	}
+
+	laptop := login("Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0")
+	phone := login("Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0 Mobile Safari/537.36")
+
+	rr := httptest.NewRequest(http.MethodGet, "/api/v1/me/sessions", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: laptop})
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var sessions []middleware.SessionInfo
+	err = json.NewDecoder(ww.Body).Decode(&sessions)
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, len(sessions), 2)
+
+	devices := make(map[string]bool)
+	for _, s := range sessions {
+		devices[s.Device] = s.Current
+	}
+	assert.True(t, devices["Firefox on Linux"])
+	assert.False(t, devices["Chrome on Android"])
+
+	// Log out everywhere else
+	rr = httptest.NewRequest(http.MethodDelete, "/api/v1/me/sessions", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: laptop})
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	rr = httptest.NewRequest(http.MethodGet, "/api/v1/restricted", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: phone})
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+
+	assert.Equal(t, http.StatusUnauthorized, ww.Code)
+
+	rr = httptest.NewRequest(http.MethodGet, "/api/v1/restricted", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: laptop})
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+
+	assert.Equal(t, http.StatusOK, ww.Code)
+}
+
+// capturingMailer keeps sent emails so that verification links can be followed.
+type capturingMailer struct {
+	sent []mail.Message
+}
+
+func (c *capturingMailer) Send(_ context.Context, msg mail.Message) error {
+	c.sent = append(c.sent, msg)
+	return nil
+}
+
+func TestHandler_ProfileIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+	mailer := &capturingMailer{}
+
+	hashedPassword, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING 
+		`, "profile@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo, WithMailer(mailer), WithPublicURL("http://localhost"))
+
+	login := func(password string) string {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(&LoginRequest{
+			Email:    "profile@example.com",
+			Password: password,
+		})
+		assert.Nil(t, err)
+
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, httptest.NewRequest(http.MethodPost, "/api/v1/login", &buf))
+		assert.Equal(t, http.StatusOK, ww.Code)
+
+		token, err := extractToken(ww.Header().Get("Set-Cookie"))
+		assert.Nil(t, err)
+		return token
+	}
+
+	do := func(method, target, token string, body any) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			err := json.NewEncoder(&buf).Encode(body)
+			assert.Nil(t, err)
+		}
+		rr := httptest.NewRequest(method, target, &buf)
+		if token != "" {
+			rr.AddCookie(&http.Cookie{Name: sessionName, Value: token})
+		}
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, rr)
+		return ww
+	}
+
+	token := login("highEntropyPassword")
+
+	// Update names
+	ww := do(http.MethodPatch, "/api/v1/me", token, map[string]string{
+		"first_name": "Jane",
+		"last_name":  "Doe",
+	})
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var profile ProfileResponse
+	err = json.NewDecoder(ww.Body).Decode(&profile)
+	assert.Nil(t, err)
+	assert.Equal(t, "Jane", profile.FirstName)
+	assert.Equal(t, "Doe", profile.LastName)
+	assert.Equal(t, "profile@example.com", profile.Email)
+
+	// Password change needs current password
+	ww = do(http.MethodPatch, "/api/v1/me", token, map[string]string{
+		"password": "anotherHighEntropyPassword",
+	})
+	assert.Equal(t, http.StatusForbidden, ww.Code)
+
+	ww = do(http.MethodPatch, "/api/v1/me", token, map[string]string{
+		"password":         "anotherHighEntropyPassword",
+		"current_password": "highEntropyPassword",
+	})
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	// Nothing is saved when the new email is taken
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING
+		`, "taken-profile@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	ww = do(http.MethodPatch, "/api/v1/me", token, map[string]string{
+		"first_name": "John",
+		"email":      "taken-profile@example.com",
+	})
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+
+	ww = do(http.MethodGet, "/api/v1/me", token, nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	err = json.NewDecoder(ww.Body).Decode(&profile)
+	assert.Nil(t, err)
+	assert.Equal(t, "Jane", profile.FirstName)
+	assert.Empty(t, profile.PendingEmail)
+
+	// Email is only changed after verification
+	ww = do(http.MethodPatch, "/api/v1/me", token, map[string]string{
+		"email": "new-profile@example.com",
+	})
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	err = json.NewDecoder(ww.Body).Decode(&profile)
+	assert.Nil(t, err)
+	assert.Equal(t, "profile@example.com", profile.Email)
+	assert.Equal(t, "new-profile@example.com", profile.PendingEmail)
+
+	assert.Len(t, mailer.sent, 1)
+	assert.Equal(t, "new-profile@example.com", mailer.sent[0].To)
+
+	start := strings.Index(mailer.sent[0].Body, "http://localhost/")
+	assert.NotEqual(t, -1, start)
+	link, _, _ := strings.Cut(mailer.sent[0].Body[start+len("http://localhost"):], "\n")
+
+	ww = do(http.MethodGet, "/api/v1/email/verify?token=invalid", "", nil)
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+
+	ww = do(http.MethodGet, link, "", nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	ww = do(http.MethodGet, "/api/v1/me", token, nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	err = json.NewDecoder(ww.Body).Decode(&profile)
+	assert.Nil(t, err)
+	assert.Equal(t, "new-profile@example.com", profile.Email)
+	assert.Empty(t, profile.PendingEmail)
+	assert.NotNil(t, profile.VerifiedAt)
+
+	// Export
+	ww = do(http.MethodGet, "/api/v1/me/export", token, nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.Contains(t, ww.Header().Get("Content-Disposition"), "attachment")
+
+	var export ExportResponse
+	err = json.NewDecoder(ww.Body).Decode(&export)
+	assert.Nil(t, err)
+	assert.Equal(t, profile.ID, export.Profile.ID)
+	assert.NotEmpty(t, export.Sessions)
+	assert.NotContains(t, ww.Body.String(), "password")
+
+	// Deletion requires re-authentication
+	ww = do(http.MethodDelete, "/api/v1/me", token, DeleteAccountRequest{Password: "highEntropyPassword"})
+	assert.Equal(t, http.StatusForbidden, ww.Code)
+
+	ww = do(http.MethodDelete, "/api/v1/me", token, DeleteAccountRequest{Password: "anotherHighEntropyPassword"})
+	assert.Equal(t, http.StatusNoContent, ww.Code)
+
+	ww = do(http.MethodGet, "/api/v1/me", token, nil)
+	assert.Equal(t, http.StatusUnauthorized, ww.Code)
+
+	exists, err := client.User.Query().Where(user.IDEQ(profile.ID)).Exist(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestHandler_Password_UpgradeIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	cfg := config.Password{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("highEntropyPassword"), bcrypt.MinCost)
+	assert.Nil(t, err)
+
+	outdatedHash, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		email  string
+		hashed string
+	}{
+		{
+			name:   "bcrypt",
+			email:  "bcrypt@example.com",
+			hashed: string(bcryptHash),
+		},
+		{
+			name:   "django pbkdf2",
+			email:  "pbkdf2@example.com",
+			hashed: "pbkdf2_sha256$260000$Zm9vYmFyc2FsdA$5pkLsbobWiOwW9v04mxgwFCJERR+hRdfbB3SLyyA9qs=",
+		},
+		{
+			name:   "argon2id with outdated parameters",
+			email:  "argon2@example.com",
+			hashed: outdatedHash,
+		},
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo, WithPasswordHasher(password.New(cfg)))
+
+	login := func(email, password string) int {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(&LoginRequest{Email: email, Password: password})
+		assert.Nil(t, err)
+
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, httptest.NewRequest(http.MethodPost, "/api/v1/login", &buf))
+		return ww.Code
+	}
+
+	storedHash := func(email string) string {
+		u, err := client.User.Query().Where(user.EmailEQ(email)).Only(context.Background())
+		assert.Nil(t, err)
+		return u.Password
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.db.ExecContext(context.Background(), `
+				INSERT INTO users (email, password) VALUES ($1, $2)
+				ON CONFLICT (email) DO UPDATE SET password = EXCLUDED.password
+				`, tt.email, tt.hashed)
+			assert.Nil(t, err)
+
+			// A failed login leaves the hash alone.
+			assert.Equal(t, http.StatusUnauthorized, login(tt.email, "wrongPassword"))
+			assert.Equal(t, tt.hashed, storedHash(tt.email))
+
+			assert.Equal(t, http.StatusOK, login(tt.email, "highEntropyPassword"))
+
+			params, _, _, err := argon2id.DecodeHash(storedHash(tt.email))
+			assert.Nil(t, err)
+			assert.Equal(t, cfg.Memory, params.Memory)
+			assert.Equal(t, cfg.Iterations, params.Iterations)
+			assert.Equal(t, cfg.Parallelism, params.Parallelism)
+
+			// Upgraded hash still works.
+			assert.Equal(t, http.StatusOK, login(tt.email, "highEntropyPassword"))
+		})
+	}
+}
+
+func TestHandler_MagicLinkIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+	mailer := &capturingMailer{}
+
+	_, err := repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, '')
+		ON CONFLICT (email) DO NOTHING 
+		`, "magic@example.com")
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo,
+		WithMailer(mailer),
+		WithPublicURL("http://localhost"),
+		WithMagicLink(config.MagicLink{Enable: true, Lifetime: 15 * time.Minute}),
+	)
+
+	requestLink := func(email string) *http.Cookie {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(&MagicLinkRequest{Email: email})
+		assert.Nil(t, err)
+
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, httptest.NewRequest(http.MethodPost, "/api/v1/login/magic", &buf))
+		assert.Equal(t, http.StatusAccepted, ww.Code)
+
+		for _, cookie := range ww.Result().Cookies() {
+			if cookie.Name == magicLinkCookie {
+				return cookie
+			}
+		}
+		t.Fatal("nonce cookie is not set")
+		return nil
+	}
+
+	open := func(link string, nonce *http.Cookie) *httptest.ResponseRecorder {
+		rr := httptest.NewRequest(http.MethodGet, link, nil)
+		if nonce != nil {
+			rr.AddCookie(nonce)
+		}
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, rr)
+		return ww
+	}
+
+	// Unknown email looks the same to the requester but sends nothing.
+	requestLink("nobody@example.com")
+	assert.Len(t, mailer.sent, 0)
+
+	nonce := requestLink("magic@example.com")
+	assert.Len(t, mailer.sent, 1)
+	assert.Equal(t, "magic@example.com", mailer.sent[0].To)
+
+	start := strings.Index(mailer.sent[0].Body, "http://localhost/")
+	assert.NotEqual(t, -1, start)
+	link, _, _ := strings.Cut(mailer.sent[0].Body[start+len("http://localhost"):], "\n")
+
+	// Opened without the cookie, or in another browser, it is rejected and
+	// stays usable.
+	assert.Equal(t, http.StatusBadRequest, open(link, nil).Code)
+	other := requestLink("magic@example.com")
+	assert.Equal(t, http.StatusBadRequest, open(link, other).Code)
+
+	ww := open(link, nonce)
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var token string
+	for _, cookie := range ww.Result().Cookies() {
+		switch cookie.Name {
+		case sessionName:
+			token = cookie.Value
+		case magicLinkCookie:
+			assert.Equal(t, -1, cookie.MaxAge)
+		}
+	}
+	assert.NotEmpty(t, token)
+
+	rr := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: token})
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var profile ProfileResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&profile))
+	assert.NotNil(t, profile.VerifiedAt)
+
+	// Single use.
+	assert.Equal(t, http.StatusBadRequest, open(link, nonce).Code)
+}
+
+func TestHandler_InvitationIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+
+	hashedPassword, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING 
+		`, "email@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo, WithRegistration(config.Registration{InviteOnly: true}))
+
+	do := func(method, target, token string, body any) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			err := json.NewEncoder(&buf).Encode(body)
+			assert.Nil(t, err)
+		}
+		rr := httptest.NewRequest(method, target, &buf)
+		if token != "" {
+			rr.AddCookie(&http.Cookie{Name: sessionName, Value: token})
+		}
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, rr)
+		return ww
+	}
+
+	login := func(email string) string {
+		ww := do(http.MethodPost, "/api/v1/login", "", &LoginRequest{Email: email, Password: "highEntropyPassword"})
+		assert.Equal(t, http.StatusOK, ww.Code)
+
+		token, err := extractToken(ww.Header().Get("Set-Cookie"))
+		assert.Nil(t, err)
+		return token
+	}
+
+	register := func(email, code string) int {
+		return do(http.MethodPost, "/api/v1/register", "", &RegisterRequest{
+			Email:          email,
+			Password:       "highEntropyPassword",
+			InvitationCode: code,
+		}).Code
+	}
+
+	createInvitation := func(token string, req CreateInvitationRequest) (int, CreatedInvitationResponse) {
+		ww := do(http.MethodPost, "/api/v1/invitations", token, req)
+		var created CreatedInvitationResponse
+		if ww.Code == http.StatusCreated {
+			assert.Nil(t, json.NewDecoder(ww.Body).Decode(&created))
+		}
+		return ww.Code, created
+	}
+
+	assert.Equal(t, http.StatusBadRequest, register("uninvited@example.com", ""))
+	assert.Equal(t, http.StatusBadRequest, register("uninvited@example.com", "made-up"))
+
+	adminToken := login("admin@gmhafiz.com")
+
+	status, invitation := createInvitation(adminToken, CreateInvitationRequest{Roles: []string{middleware.RoleInviter}})
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, 1, invitation.MaxUses)
+	assert.NotEmpty(t, invitation.Code)
+
+	assert.Equal(t, http.StatusCreated, register("invited@example.com", invitation.Code))
+	// Used up.
+	assert.Equal(t, http.StatusBadRequest, register("invited-again@example.com", invitation.Code))
+
+	ww := do(http.MethodGet, fmt.Sprintf("/api/v1/invitations/%d", invitation.ID), adminToken, nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	var got InvitationResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Equal(t, 1, got.Uses)
+	assert.Len(t, got.UsedBy, 1)
+	assert.Equal(t, "invited@example.com", got.UsedBy[0].Email)
+
+	// Invited user has the inviter role, but cannot give out admin.
+	inviterToken := login("invited@example.com")
+	status, _ = createInvitation(inviterToken, CreateInvitationRequest{Roles: []string{middleware.RoleAdmin}})
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, own := createInvitation(inviterToken, CreateInvitationRequest{MaxUses: 5})
+	assert.Equal(t, http.StatusCreated, status)
+
+	// Inviters only see their own invitations.
+	ww = do(http.MethodGet, "/api/v1/invitations", inviterToken, nil)
+	assert.Equal(t, http.StatusOK, ww.Code)
+	var list []InvitationResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&list))
+	assert.Len(t, list, 1)
+	assert.Equal(t, own.ID, list[0].ID)
+
+	ww = do(http.MethodDelete, fmt.Sprintf("/api/v1/invitations/%d", invitation.ID), inviterToken, nil)
+	assert.Equal(t, http.StatusNotFound, ww.Code)
+
+	ww = do(http.MethodDelete, fmt.Sprintf("/api/v1/invitations/%d", own.ID), inviterToken, nil)
+	assert.Equal(t, http.StatusNoContent, ww.Code)
+	assert.Equal(t, http.StatusBadRequest, register("revoked@example.com", own.Code))
+
+	// A user without the role cannot invite.
+	status, _ = createInvitation(login("email@example.com"), CreateInvitationRequest{})
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+func TestHandler_LoginEventsIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := dbClient()
+	session := newSession(migrator.DB, 1*time.Hour)
+	repo := NewRepo(client, migrator.DB, session)
+	notifier := &capturingMailer{}
+
+	hashedPassword, err := argon2id.CreateHash("highEntropyPassword", argon2id.DefaultParams)
+	assert.Nil(t, err)
+
+	_, err = repo.db.ExecContext(context.Background(), `
+		INSERT INTO users (email, password) VALUES ($1, $2)
+		ON CONFLICT (email) DO NOTHING 
+		`, "events@example.com", hashedPassword)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, session, repo, WithNotifier(notifier))
+
+	const (
+		laptop = "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0"
+		phone  = "Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0 Mobile Safari/537.36"
+	)
+
+	login := func(pass, userAgent, ip string) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(&LoginRequest{
+			Email:    "events@example.com",
+			Password: pass,
+		})
+		assert.Nil(t, err)
+
+		rr := httptest.NewRequest(http.MethodPost, "/api/v1/login", &buf)
+		rr.Header.Set("User-Agent", userAgent)
+		rr.RemoteAddr = ip + ":1234"
+		ww := httptest.NewRecorder()
+		router.ServeHTTP(ww, rr)
+		return ww
+	}
+
+	// The very first login is not from a "new" device.
+	assert.Equal(t, http.StatusOK, login("highEntropyPassword", laptop, "203.0.113.5").Code)
+	assert.Len(t, notifier.sent, 0)
+
+	// Same device on the same network.
+	assert.Equal(t, http.StatusOK, login("highEntropyPassword", laptop, "203.0.113.9").Code)
+	assert.Len(t, notifier.sent, 0)
+
+	assert.Equal(t, http.StatusUnauthorized, login("wrongPassword", phone, "198.51.100.7").Code)
+	assert.Len(t, notifier.sent, 0)
+
+	ww := login("highEntropyPassword", phone, "198.51.100.7")
+	assert.Equal(t, http.StatusOK, ww.Code)
+	assert.Len(t, notifier.sent, 1)
+	assert.Equal(t, "events@example.com", notifier.sent[0].To)
+	assert.Contains(t, notifier.sent[0].Body, "Chrome on Android")
+
+	token, err := extractToken(ww.Header().Get("Set-Cookie"))
+	assert.Nil(t, err)
+
+	rr := httptest.NewRequest(http.MethodGet, "/api/v1/me/login_events", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: token})
+	ww = httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var got struct {
+		Data []LoginEventResponse `json:"data"`
+	}
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Len(t, got.Data, 4)
+
+	// Newest first.
+	assert.Equal(t, EventLoginSucceeded, got.Data[0].Type)
+	assert.True(t, got.Data[0].NewDevice)
+	assert.Equal(t, EventLoginFailed, got.Data[1].Type)
+	assert.Equal(t, ReasonWrongPassword, got.Data[1].Reason)
+	assert.Equal(t, "198.51.100.7", got.Data[1].IPAddress)
+	assert.False(t, got.Data[2].NewDevice)
+
+	rr = httptest.NewRequest(http.MethodPost, "/api/v1/logout", nil)
+	rr.AddCookie(&http.Cookie{Name: sessionName, Value: token})
+	router.ServeHTTP(httptest.NewRecorder(), rr)
+
+	u, err := client.User.Query().Where(user.Email("events@example.com")).Only(context.Background())
+	assert.Nil(t, err)
+	events, _, err := repo.ListLoginEvents(context.Background(), u.ID, &filter.Filter{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, EventLogout, events[0].Type)
+}
+
+func TestNetwork(t *testing.T) {
+	tests := map[string]string{
+		"203.0.113.5":                  "203.0.113.0/24",
+		"203.0.113.5:51234":            "203.0.113.0/24",
+		"203.0.113.5, 10.0.0.1":        "203.0.113.0/24",
+		"::ffff:203.0.113.5":           "203.0.113.0/24",
+		"2001:db8:1234:5678::1":        "2001:db8:1234::/48",
+		"[2001:db8:1234:5678::1]:8080": "2001:db8:1234::/48",
+		"":                             "",
+		"not an ip":                    "",
+	}
+
+	for ip, want := range tests {
+		assert.Equal(t, want, network(ip), ip)
+	}
+}
+
 func extractToken(cookie string) (string, error) {
 	parts := strings.Split(cookie, ";")
 	if len(parts) == 0 {
@@ -986,8 +1887,9 @@ func dbClient() *gen.Client {
 
 func newSession(db *sql.DB, duration time.Duration) *scs.SessionManager {
 	manager := scs.New()
-	manager.Store = postgresstore.New(db)
-	manager.CtxStore = postgresstore.New(db)
+	store := postgresstore.New(db, hasher)
+	manager.Store = store
+	manager.CtxStore = store
 	manager.Lifetime = duration
 	manager.Cookie.Name = sessionName
 	manager.Cookie.HttpOnly = false
@@ -0,0 +1,80 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/third_party/mail"
+)
+
+// Logins records login_events and alerts users to logins from new devices.
+// It is shared with other ways of logging in, such as OpenID Connect.
+type Logins struct {
+	repo     Repo
+	notifier mail.Mailer
+}
+
+// NewLogins records events into repo and sends alerts through notifier.
+func NewLogins(repo Repo, notifier mail.Mailer) *Logins {
+	return &Logins{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+// LoggedIn records a successful login and alerts the user when it comes from a
+// device or network they have not logged in from before.
+func (l *Logins) LoggedIn(ctx context.Context, userID uint64, method string) {
+	event := l.record(ctx, &userID, EventLoginSucceeded, method, "")
+	if event == nil || !event.NewDevice {
+		return
+	}
+
+	profile, err := l.repo.Profile(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "reading profile for new device alert", "error", err)
+		return
+	}
+
+	if err := l.sendNewDeviceAlert(ctx, profile.User.Email, event); err != nil {
+		slog.WarnContext(ctx, "queueing new device alert", "error", err)
+	}
+}
+
+// record saves where an authentication event came from. Failing to do so is
+// logged but does not fail the request.
+func (l *Logins) record(ctx context.Context, userID *uint64, eventType, method, reason string) *gen.LoginEvent {
+	client := middleware.ClientFromContext(ctx)
+
+	event, err := l.repo.RecordLoginEvent(ctx, &gen.LoginEvent{
+		UserID:    userID,
+		Type:      eventType,
+		Method:    method,
+		Reason:    reason,
+		IPAddress: client.IPAddress,
+		Network:   network(client.IPAddress),
+		UserAgent: client.UserAgent,
+		Device:    client.Device,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "recording login event", "type", eventType, "error", err)
+		return nil
+	}
+
+	return event
+}
+
+func (l *Logins) sendNewDeviceAlert(ctx context.Context, email string, event *gen.LoginEvent) error {
+	return l.notifier.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "New sign-in to your account",
+		Body: fmt.Sprintf("Your account was signed in to from a new device or network.\n\n"+
+			"Device: %s\nIP address: %s\nTime: %s\n\n"+
+			"If this was you, you can ignore this email. If not, change your password and log out "+
+			"of other sessions right away.", event.Device, event.IPAddress, event.CreatedAt.UTC().Format(time.RFC1123)),
+	})
+}
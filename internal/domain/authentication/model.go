@@ -1,6 +1,62 @@
 package authentication
 
+import (
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
 type User struct {
 	ID       int
 	Username string
 }
+
+type Profile struct {
+	User *gen.User
+	// PendingEmail is a new email address waiting to be verified.
+	PendingEmail string
+}
+
+// Export is everything stored about a user.
+type Export struct {
+	Profile     *Profile
+	Identities  []*gen.UserIdentity
+	Tokens      []*gen.PersonalAccessToken
+	Sessions    []middleware.SessionInfo
+	LoginEvents []*gen.LoginEvent
+}
+
+// Types of login_events.
+const (
+	EventLoginSucceeded  = "login_succeeded"
+	EventLoginFailed     = "login_failed"
+	EventLogout          = "logout"
+	EventPasswordChanged = "password_changed"
+)
+
+// Ways of logging in, recorded as login_events.method.
+const (
+	MethodPassword  = "password"
+	MethodMagicLink = "magic_link"
+	MethodOIDC      = "oidc"
+)
+
+// Reasons a login failed, recorded as login_events.reason.
+const (
+	ReasonUnknownEmail  = "unknown_email"
+	ReasonWrongPassword = "wrong_password"
+	ReasonDisabled      = "disabled"
+)
+
+// Invitation is an invitation code along with users who registered with it.
+type Invitation struct {
+	*gen.Invitation
+	UsedBy []InvitationUse
+}
+
+type InvitationUse struct {
+	UserID uint64
+	Email  string
+	UsedAt time.Time
+}
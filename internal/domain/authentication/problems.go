@@ -0,0 +1,56 @@
+package authentication
+
+import (
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/third_party/password"
+)
+
+func init() {
+	respond.RegisterProblem(ErrEmailNotAvailable, respond.ProblemType{
+		Type:   "/problems/email-not-available",
+		Title:  "Email not available",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(ErrAccountDisabled, respond.ProblemType{
+		Type:   "/problems/account-disabled",
+		Title:  "Account disabled",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrWrongPassword, respond.ProblemType{
+		Type:   "/problems/wrong-password",
+		Title:  "Wrong password",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrReauthenticationNeeded, respond.ProblemType{
+		Type:   "/problems/reauthentication-needed",
+		Title:  "Reauthentication needed",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrInvitationRequired, respond.ProblemType{
+		Type:   "/problems/invitation-required",
+		Title:  "Invitation required",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(ErrInvitationInvalid, respond.ProblemType{
+		Type:   "/problems/invitation-invalid",
+		Title:  "Invitation invalid",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(ErrVerificationInvalid, respond.ProblemType{
+		Type:   "/problems/link-invalid",
+		Title:  "Link invalid",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(ErrMagicLinkInvalid, respond.ProblemType{
+		Type:   "/problems/link-invalid",
+		Title:  "Link invalid",
+		Status: http.StatusBadRequest,
+	})
+	respond.RegisterProblem(password.ErrBusy, respond.ProblemType{
+		Type:   "/problems/busy",
+		Title:  "Busy",
+		Status: http.StatusServiceUnavailable,
+	})
+}
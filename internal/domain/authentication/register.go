@@ -1,27 +1,75 @@
 package authentication
 
 import (
+	"time"
+
 	"github.com/gmhafiz/scs/v2"
 	"github.com/go-chi/chi/v5"
 
 	"github.com/gmhafiz/go8/internal/middleware"
 )
 
-func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo) {
-	h := NewHandler(session, repo)
+// exportTimeout is how long gathering everything about a user may take,
+// longer than other requests.
+const exportTimeout = time.Minute
+
+func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo, opts ...Option) {
+	h := NewHandler(session, repo, opts...)
+	authenticate := middleware.Authenticate(session,
+		middleware.WithTokenStore(repo),
+		middleware.WithUserStore(repo),
+	)
 
-	router.Post("/api/v1/login", h.Login)
+	// Routes that log a user in.
+	router.Group(func(router chi.Router) {
+		if h.loginLimit != nil {
+			router.Use(h.loginLimit)
+		}
+		router.Post("/api/v1/login", h.Login)
+		if h.magicLink.Enable {
+			router.Post("/api/v1/login/magic", h.RequestMagicLink)
+			router.Get("/api/v1/login/magic/verify", h.ConsumeMagicLink)
+		}
+	})
 	router.Post("/api/v1/register", h.Register)
+	router.Get("/api/v1/email/verify", h.VerifyEmail)
 
 	router.Route("/api/v1/logout", func(router chi.Router) {
 		router.Post("/", h.Logout)
 	})
 
 	router.Route("/api/v1/restricted", func(router chi.Router) {
-		router.Use(middleware.Authenticate(session))
+		router.Use(authenticate)
 		router.Get("/csrf", h.Csrf)
 		router.Get("/", h.Protected)
 		router.Get("/me", h.Me)
 		router.Post("/logout/{userID}", h.ForceLogout)
 	})
+
+	router.Route("/api/v1/me", func(router chi.Router) {
+		router.Use(authenticate)
+		router.Get("/", h.Profile)
+		router.Patch("/", h.UpdateProfile)
+		router.Delete("/", h.DeleteAccount)
+		router.With(middleware.Timeout(exportTimeout)).Get("/export", h.Export)
+		router.Get("/login_events", h.ListLoginEvents)
+		router.Get("/sessions", h.ListSessions)
+		router.Delete("/sessions", h.RevokeOtherSessions)
+		router.Delete("/sessions/{sessionID}", h.RevokeSession)
+	})
+
+	router.Route("/api/v1/invitations", func(router chi.Router) {
+		router.Use(authenticate)
+		router.Get("/", h.ListInvitations)
+		router.Post("/", h.CreateInvitation)
+		router.Get("/{invitationID}", h.GetInvitation)
+		router.Delete("/{invitationID}", h.RevokeInvitation)
+	})
+
+	router.Route("/api/v1/tokens", func(router chi.Router) {
+		router.Use(authenticate)
+		router.Get("/", h.ListTokens)
+		router.Post("/", h.CreateToken)
+		router.Delete("/{tokenID}", h.RevokeToken)
+	})
 }
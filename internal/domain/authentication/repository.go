@@ -3,95 +3,338 @@ package authentication
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"time"
 
-	"github.com/alexedwards/argon2id"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/gmhafiz/scs/v2"
 
 	"github.com/gmhafiz/go8/ent/gen"
-	"github.com/gmhafiz/go8/ent/gen/session"
+	"github.com/gmhafiz/go8/ent/gen/emailverification"
+	"github.com/gmhafiz/go8/ent/gen/invitation"
+	"github.com/gmhafiz/go8/ent/gen/invitationuse"
+	"github.com/gmhafiz/go8/ent/gen/loginevent"
+	"github.com/gmhafiz/go8/ent/gen/magiclink"
+	"github.com/gmhafiz/go8/ent/gen/personalaccesstoken"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
 	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/ent/gen/useridentity"
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/filter"
 )
 
 type repo struct {
-	ent     *gen.Client
-	db      *sql.DB
-	session *scs.SessionManager
+	ent      *gen.Client
+	db       *sql.DB
+	session  *scs.SessionManager
+	sessions middleware.UserSessionStore
 }
 
 var (
 	ErrEmailNotAvailable = errors.New("email is not available")
 	ErrNotLoggedIn       = errors.New("you are not logged in yet")
+	ErrTokenNotFound     = errors.New("token not found")
+
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrSessionsNotSupported = errors.New("session store does not track user sessions")
+
+	ErrVerificationInvalid = errors.New("verification link is invalid or has expired")
+	ErrInvitationInvalid   = errors.New("invitation code is invalid, has expired or has been used up")
+	ErrInvitationNotFound  = errors.New("invitation not found")
+	ErrMagicLinkInvalid    = errors.New("sign-in link is invalid, has expired or was opened in a different browser")
 )
 
+const emailVerificationLifetime = 24 * time.Hour
+
 type Repo interface {
-	Register(ctx context.Context, firstName, lastName, email, hashedPassword string) error
-	Login(ctx context.Context, req LoginRequest) (*gen.User, bool, error)
+	// Register creates a user. A non-empty invitationCode is used up and its
+	// roles are given to the new user.
+	Register(ctx context.Context, firstName, lastName, email, hashedPassword, invitationCode string) error
+	UserByEmail(ctx context.Context, email string) (*gen.User, error)
+	// EmailTaken reports whether a user has this email, in any letter case.
+	EmailTaken(ctx context.Context, email string) (bool, error)
+	// CreateMagicLink returns a sign-in token for the user with this email.
+	// Token is empty when there is no such user or the user is disabled.
+	CreateMagicLink(ctx context.Context, email, nonce string, lifetime time.Duration) (string, error)
+	// ConsumeMagicLink returns the user ID a sign-in token belongs to and
+	// deletes the token. The nonce must match the one given when the token was
+	// created.
+	ConsumeMagicLink(ctx context.Context, token, nonce string) (uint64, error)
 	Logout(ctx context.Context, userID uint64) (bool, error)
 	Csrf(ctx context.Context) (string, error)
+
+	CreateToken(ctx context.Context, userID uint64, req CreateTokenRequest) (*gen.PersonalAccessToken, string, error)
+	ListTokens(ctx context.Context, userID uint64) ([]*gen.PersonalAccessToken, error)
+	DeleteToken(ctx context.Context, userID, tokenID uint64) error
+	FindToken(ctx context.Context, token string) (uint64, []string, bool, error)
+	TokenExists(ctx context.Context, token string) (bool, error)
+	FindUser(ctx context.Context, userID uint64) ([]string, bool, bool, error)
+
+	ListSessions(ctx context.Context, userID uint64, currentToken string) ([]middleware.SessionInfo, error)
+	RevokeSession(ctx context.Context, userID uint64, sessionID string) error
+	RevokeOtherSessions(ctx context.Context, userID uint64, currentToken string) (int64, error)
+
+	// Invitations are limited to those created by createdBy, or all of them
+	// when createdBy is 0.
+	CreateInvitation(ctx context.Context, createdBy uint64, req CreateInvitationRequest) (*gen.Invitation, string, error)
+	ListInvitations(ctx context.Context, createdBy uint64) ([]Invitation, error)
+	ReadInvitation(ctx context.Context, createdBy, invitationID uint64) (*Invitation, error)
+	RevokeInvitation(ctx context.Context, createdBy, invitationID uint64) error
+
+	Profile(ctx context.Context, userID uint64) (*Profile, error)
+	UpdateProfile(ctx context.Context, userID uint64, req UpdateProfileRequest) error
+	ChangePassword(ctx context.Context, userID uint64, hashedPassword string) error
+	RequestEmailChange(ctx context.Context, userID uint64, email string) (string, error)
+	VerifyEmail(ctx context.Context, token string) error
+	DeleteAccount(ctx context.Context, userID uint64) error
+	Export(ctx context.Context, userID uint64, currentToken string) (*Export, error)
+
+	// RecordLoginEvent saves an authentication event. A successful login is
+	// flagged as NewDevice when the user has logged in before, but never from
+	// this device or network.
+	RecordLoginEvent(ctx context.Context, event *gen.LoginEvent) (*gen.LoginEvent, error)
+	ListLoginEvents(ctx context.Context, userID uint64, f *filter.Filter) ([]*gen.LoginEvent, int, error)
 }
 
-func (r *repo) Register(ctx context.Context, firstName, lastName, email, hashedPassword string) error {
-	err := r.ent.User.Create().
+func (r *repo) Register(ctx context.Context, firstName, lastName, email, hashedPassword, invitationCode string) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var invited *gen.Invitation
+	if invitationCode != "" {
+		invited, err = useInvitation(ctx, tx, invitationCode)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	create := tx.User.Create().
 		SetFirstName(firstName).
 		SetLastName(lastName).
 		SetEmail(email).
-		SetPassword(hashedPassword).
-		Exec(ctx)
+		SetPassword(hashedPassword)
+	if invited != nil {
+		create.SetRoles(invited.Roles)
+	}
+
+	u, err := create.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		if gen.IsConstraintError(err) {
 			return ErrEmailNotAvailable
 		}
 		return err
 	}
 
-	return nil
+	if invited != nil {
+		err = tx.InvitationUse.Create().
+			SetInvitationID(invited.ID).
+			SetUserID(u.ID).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
-func (r *repo) Login(ctx context.Context, req LoginRequest) (*gen.User, bool, error) {
-	u, err := r.ent.User.Query().Where(user.EmailEqualFold(req.Email)).First(ctx)
+// useInvitation counts a use of a valid invitation. The check and increment
+// happen in a single statement so that concurrent registrations cannot go
+// over max_uses.
+func useInvitation(ctx context.Context, tx *gen.Tx, code string) (*gen.Invitation, error) {
+	codeHash := hashToken(code)
+
+	updated, err := tx.Invitation.Update().
+		Where(
+			invitation.CodeHashEQ(codeHash),
+			invitation.RevokedAtIsNil(),
+			invitation.ExpiresAtGT(time.Now()),
+			func(s *entsql.Selector) {
+				s.Where(entsql.ColumnsLT(s.C(invitation.FieldUses), s.C(invitation.FieldMaxUses)))
+			},
+		).
+		AddUses(1).
+		Save(ctx)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
+	if updated == 0 {
+		return nil, ErrInvitationInvalid
+	}
+
+	return tx.Invitation.Query().Where(invitation.CodeHashEQ(codeHash)).Only(ctx)
+}
+
+func (r *repo) UserByEmail(ctx context.Context, email string) (*gen.User, error) {
+	return r.ent.User.Query().Where(user.EmailEqualFold(email)).First(ctx)
+}
 
-	match, err := argon2id.ComparePasswordAndHash(req.Password, u.Password)
+func (r *repo) EmailTaken(ctx context.Context, email string) (bool, error) {
+	return r.ent.User.Query().Where(user.EmailEqualFold(email)).Exist(ctx)
+}
+
+func (r *repo) CreateMagicLink(ctx context.Context, email, nonce string, lifetime time.Duration) (string, error) {
+	u, err := r.ent.User.Query().
+		Where(
+			user.EmailEqualFold(email),
+			user.DisabledAtIsNil(),
+		).
+		First(ctx)
+	if gen.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	token, err := generateToken()
 	if err != nil {
-		return nil, false, errors.New("wrong password is provided")
+		return "", err
 	}
 
-	return u, match, nil
+	// Expired links of this user are of no use, so they are cleaned up here.
+	_, err = r.ent.MagicLink.Delete().
+		Where(
+			magiclink.UserIDEQ(u.ID),
+			magiclink.ExpiresAtLTE(time.Now()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = r.ent.MagicLink.Create().
+		SetUserID(u.ID).
+		SetTokenHash(hashToken(token)).
+		SetNonceHash(hashToken(nonce)).
+		SetExpiresAt(time.Now().Add(lifetime)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (r *repo) ConsumeMagicLink(ctx context.Context, token, nonce string) (uint64, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	link, err := tx.MagicLink.Query().
+		Where(
+			magiclink.TokenHashEQ(hashToken(token)),
+			magiclink.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if gen.IsNotFound(err) {
+			return 0, ErrMagicLinkInvalid
+		}
+		return 0, err
+	}
+
+	// A link opened somewhere else, such as by an email scanner, is left
+	// untouched so that it can still be used from the right browser.
+	if subtle.ConstantTimeCompare([]byte(link.NonceHash), []byte(hashToken(nonce))) != 1 {
+		_ = tx.Rollback()
+		return 0, ErrMagicLinkInvalid
+	}
+
+	// Only one of concurrent requests using the same link gets to delete it.
+	deleted, err := tx.MagicLink.Delete().
+		Where(magiclink.ID(link.ID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if deleted != 1 {
+		_ = tx.Rollback()
+		return 0, ErrMagicLinkInvalid
+	}
+
+	u, err := tx.User.Get(ctx, link.UserID)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if u.DisabledAt != nil {
+		_ = tx.Rollback()
+		return 0, ErrAccountDisabled
+	}
+
+	// Opening the link proves the email address belongs to the user.
+	if u.VerifiedAt == nil {
+		err = tx.User.UpdateOneID(u.ID).SetVerifiedAt(time.Now()).Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+
+	return u.ID, tx.Commit()
 }
 
 func (r *repo) Logout(ctx context.Context, userID uint64) (bool, error) {
-	var found bool
-	rows := r.db.QueryRowContext(ctx, `
-			   SELECT CASE
-			   WHEN EXISTS(SELECT *
-						   FROM sessions
-						   WHERE sessions.user_id = $1)
-				   THEN true
-			   ELSE false
-			   END
-	;
-	`, userID)
-	err := rows.Scan(&found)
+	if r.sessions == nil {
+		return false, ErrSessionsNotSupported
+	}
+
+	deleted, err := r.sessions.DeleteAllByUserCtx(ctx, userID, "")
 	if err != nil {
 		return false, err
 	}
 
-	if !found {
+	if deleted == 0 {
 		return false, ErrNotLoggedIn
 	}
 
-	_, err = r.ent.Session.Delete().Where(session.UserIDEQ(userID)).Exec(ctx)
+	return true, nil
+}
+
+func (r *repo) ListSessions(ctx context.Context, userID uint64, currentToken string) ([]middleware.SessionInfo, error) {
+	if r.sessions == nil {
+		return nil, ErrSessionsNotSupported
+	}
+
+	return r.sessions.ListByUserCtx(ctx, userID, currentToken)
+}
+
+func (r *repo) RevokeSession(ctx context.Context, userID uint64, sessionID string) error {
+	if r.sessions == nil {
+		return ErrSessionsNotSupported
+	}
+
+	deleted, err := r.sessions.DeleteByUserCtx(ctx, userID, sessionID)
 	if err != nil {
-		return false, err
+		return err
+	}
+	if !deleted {
+		return ErrSessionNotFound
 	}
 
-	return true, nil
+	return nil
+}
+
+func (r *repo) RevokeOtherSessions(ctx context.Context, userID uint64, currentToken string) (int64, error) {
+	if r.sessions == nil {
+		return 0, ErrSessionsNotSupported
+	}
+
+	return r.sessions.DeleteAllByUserCtx(ctx, userID, currentToken)
 }
 
 func (r *repo) Csrf(ctx context.Context) (string, error) {
@@ -100,6 +343,10 @@ func (r *repo) Csrf(ctx context.Context) (string, error) {
 		return "", err
 	}
 
+	// A csrf token is stored alongside sessions, but it is not a session of the
+	// logged-in user, so it must not show up in their list of sessions.
+	ctx = context.WithValue(ctx, middleware.KeyID, nil)
+
 	err = r.session.CtxStore.CommitCtx(ctx, token, []byte("csrf_token"), time.Now().Add(r.session.Lifetime))
 	if err != nil {
 		return "", err
@@ -108,11 +355,522 @@ func (r *repo) Csrf(ctx context.Context) (string, error) {
 	return token, nil
 }
 
+// CreateToken generates a new personal access token. Only a hash of the token is
+// stored, so the returned plain text token cannot be retrieved again.
+func (r *repo) CreateToken(ctx context.Context, userID uint64, req CreateTokenRequest) (*gen.PersonalAccessToken, string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	created, err := r.ent.PersonalAccessToken.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		SetTokenHash(hashToken(token)).
+		SetScopes(req.Scopes).
+		SetNillableExpiresAt(req.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, token, nil
+}
+
+func (r *repo) ListTokens(ctx context.Context, userID uint64) ([]*gen.PersonalAccessToken, error) {
+	return r.ent.PersonalAccessToken.Query().
+		Where(personalaccesstoken.UserIDEQ(userID)).
+		Order(gen.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *repo) DeleteToken(ctx context.Context, userID, tokenID uint64) error {
+	deleted, err := r.ent.PersonalAccessToken.Delete().
+		Where(
+			personalaccesstoken.IDEQ(tokenID),
+			personalaccesstoken.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrTokenNotFound
+	}
+
+	return nil
+}
+
+// FindToken looks up a valid personal access token and records its usage in
+// the same query. Like sessions, last used time is updated at most once a
+// minute so that a read is not turned into a write on every request.
+func (r *repo) FindToken(ctx context.Context, token string) (uint64, []string, bool, error) {
+	var (
+		userID uint64
+		scopes []byte
+	)
+	err := r.db.QueryRowContext(ctx, `
+		WITH found AS (
+			SELECT id, user_id, scopes, last_used_at FROM personal_access_tokens
+			WHERE token_hash = $1
+			  AND (expires_at IS NULL OR current_timestamp < expires_at)
+		), touched AS (
+			UPDATE personal_access_tokens
+			SET last_used_at = current_timestamp
+			FROM found
+			WHERE personal_access_tokens.id = found.id
+			  AND (found.last_used_at IS NULL OR found.last_used_at < current_timestamp - interval '1 minute')
+		)
+		SELECT user_id, scopes FROM found
+	`, hashToken(token)).Scan(&userID, &scopes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil, false, nil
+	} else if err != nil {
+		return 0, nil, false, err
+	}
+
+	var s []string
+	if err = json.Unmarshal(scopes, &s); err != nil {
+		return 0, nil, false, err
+	}
+
+	return userID, s, true, nil
+}
+
+// TokenExists reports whether a personal access token is valid without
+// recording its usage, for rate limiting requests before they are let through.
+func (r *repo) TokenExists(ctx context.Context, token string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM personal_access_tokens
+			WHERE token_hash = $1
+			  AND (expires_at IS NULL OR current_timestamp < expires_at)
+		)
+	`, hashToken(token)).Scan(&exists)
+
+	return exists, err
+}
+
+// FindUser returns roles of a user and whether the account is disabled. Only
+// the needed columns are selected since this runs on every authenticated request.
+func (r *repo) FindUser(ctx context.Context, userID uint64) ([]string, bool, bool, error) {
+	u, err := r.ent.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldRoles, user.FieldDisabledAt).
+		Only(ctx)
+	if gen.IsNotFound(err) {
+		return nil, false, false, nil
+	} else if err != nil {
+		return nil, false, false, err
+	}
+
+	return u.Roles, u.DisabledAt != nil, true, nil
+}
+
+func (r *repo) CreateInvitation(ctx context.Context, createdBy uint64, req CreateInvitationRequest) (*gen.Invitation, string, error) {
+	code, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	created, err := r.ent.Invitation.Create().
+		SetCodeHash(hashToken(code)).
+		SetCreatedBy(createdBy).
+		SetRoles(req.Roles).
+		SetMaxUses(req.MaxUses).
+		SetExpiresAt(*req.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, code, nil
+}
+
+func (r *repo) ListInvitations(ctx context.Context, createdBy uint64) ([]Invitation, error) {
+	query := r.ent.Invitation.Query().Order(gen.Desc(invitation.FieldCreatedAt))
+	if createdBy != 0 {
+		query.Where(invitation.CreatedByEQ(createdBy))
+	}
+
+	invitations, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withUses(ctx, invitations)
+}
+
+func (r *repo) ReadInvitation(ctx context.Context, createdBy, invitationID uint64) (*Invitation, error) {
+	query := r.ent.Invitation.Query().Where(invitation.ID(invitationID))
+	if createdBy != 0 {
+		query.Where(invitation.CreatedByEQ(createdBy))
+	}
+
+	found, err := query.Only(ctx)
+	if gen.IsNotFound(err) {
+		return nil, ErrInvitationNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	invitations, err := r.withUses(ctx, []*gen.Invitation{found})
+	if err != nil {
+		return nil, err
+	}
+
+	return &invitations[0], nil
+}
+
+func (r *repo) RevokeInvitation(ctx context.Context, createdBy, invitationID uint64) error {
+	update := r.ent.Invitation.Update().
+		Where(
+			invitation.ID(invitationID),
+			invitation.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now())
+	if createdBy != 0 {
+		update.Where(invitation.CreatedByEQ(createdBy))
+	}
+
+	revoked, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+// withUses attaches users who registered with each invitation.
+func (r *repo) withUses(ctx context.Context, invitations []*gen.Invitation) ([]Invitation, error) {
+	ids := make([]uint64, 0, len(invitations))
+	for _, i := range invitations {
+		ids = append(ids, i.ID)
+	}
+
+	uses, err := r.ent.InvitationUse.Query().
+		Where(invitationuse.InvitationIDIn(ids...)).
+		Order(gen.Asc(invitationuse.FieldUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint64, 0, len(uses))
+	for _, use := range uses {
+		userIDs = append(userIDs, use.UserID)
+	}
+
+	users, err := r.ent.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldEmail).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[uint64]string, len(users))
+	for _, u := range users {
+		emails[u.ID] = u.Email
+	}
+
+	usedBy := make(map[uint64][]InvitationUse, len(invitations))
+	for _, use := range uses {
+		usedBy[use.InvitationID] = append(usedBy[use.InvitationID], InvitationUse{
+			UserID: use.UserID,
+			Email:  emails[use.UserID],
+			UsedAt: use.UsedAt,
+		})
+	}
+
+	result := make([]Invitation, 0, len(invitations))
+	for _, i := range invitations {
+		result = append(result, Invitation{
+			Invitation: i,
+			UsedBy:     usedBy[i.ID],
+		})
+	}
+
+	return result, nil
+}
+
+func (r *repo) Profile(ctx context.Context, userID uint64) (*Profile, error) {
+	u, err := r.ent.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &Profile{User: u}
+
+	pending, err := r.ent.EmailVerification.Query().
+		Where(
+			emailverification.UserIDEQ(userID),
+			emailverification.ExpiresAtGT(time.Now()),
+		).
+		Order(gen.Desc(emailverification.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !gen.IsNotFound(err) {
+		return nil, err
+	}
+	if pending != nil {
+		profile.PendingEmail = pending.Email
+	}
+
+	return profile, nil
+}
+
+func (r *repo) UpdateProfile(ctx context.Context, userID uint64, req UpdateProfileRequest) error {
+	return r.ent.User.UpdateOneID(userID).
+		SetNillableFirstName(req.FirstName).
+		SetNillableMiddleName(req.MiddleName).
+		SetNillableLastName(req.LastName).
+		Exec(ctx)
+}
+
+func (r *repo) ChangePassword(ctx context.Context, userID uint64, hashedPassword string) error {
+	return r.ent.User.UpdateOneID(userID).
+		SetPassword(hashedPassword).
+		Exec(ctx)
+}
+
+// RequestEmailChange records a pending email change. The email is only changed
+// once the returned token is given back to VerifyEmail. Any earlier pending
+// change is discarded.
+func (r *repo) RequestEmailChange(ctx context.Context, userID uint64, email string) (string, error) {
+	taken, err := r.ent.User.Query().
+		Where(
+			user.EmailEqualFold(email),
+			user.IDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if taken {
+		return "", ErrEmailNotAvailable
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.EmailVerification.Delete().
+		Where(emailverification.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+
+	err = tx.EmailVerification.Create().
+		SetUserID(userID).
+		SetEmail(email).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(time.Now().Add(emailVerificationLifetime)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+
+	return token, tx.Commit()
+}
+
+// VerifyEmail swaps user's email for the pending one and marks it verified.
+func (r *repo) VerifyEmail(ctx context.Context, token string) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	verification, err := tx.EmailVerification.Query().
+		Where(
+			emailverification.TokenHashEQ(hashToken(token)),
+			emailverification.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if gen.IsNotFound(err) {
+			return ErrVerificationInvalid
+		}
+		return err
+	}
+
+	err = tx.User.UpdateOneID(verification.UserID).
+		SetEmail(verification.Email).
+		SetVerifiedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if gen.IsConstraintError(err) {
+			return ErrEmailNotAvailable
+		}
+		return err
+	}
+
+	_, err = tx.EmailVerification.Delete().
+		Where(emailverification.UserIDEQ(verification.UserID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteAccount deletes a user. Tokens, identities and sessions stored in the
+// database are removed by cascading foreign keys.
+func (r *repo) DeleteAccount(ctx context.Context, userID uint64) error {
+	if r.sessions != nil {
+		if _, err := r.sessions.DeleteAllByUserCtx(ctx, userID, ""); err != nil {
+			return err
+		}
+	}
+
+	return r.ent.User.DeleteOneID(userID).Exec(ctx)
+}
+
+// Export gathers everything stored about a user.
+func (r *repo) Export(ctx context.Context, userID uint64, currentToken string) (*Export, error) {
+	profile, err := r.Profile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := r.ent.UserIdentity.Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(gen.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := r.ListTokens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []middleware.SessionInfo
+	if r.sessions != nil {
+		sessions, err = r.sessions.ListByUserCtx(ctx, userID, currentToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	loginEvents, err := r.ent.LoginEvent.Query().
+		Where(loginevent.UserIDEQ(userID)).
+		Order(loginevent.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Export{
+		Profile:     profile,
+		Identities:  identities,
+		Tokens:      tokens,
+		Sessions:    sessions,
+		LoginEvents: loginEvents,
+	}, nil
+}
+
+func (r *repo) RecordLoginEvent(ctx context.Context, event *gen.LoginEvent) (*gen.LoginEvent, error) {
+	if event.Type == EventLoginSucceeded && event.UserID != nil {
+		newDevice, err := r.isNewDevice(ctx, *event.UserID, event.Device, event.Network)
+		if err != nil {
+			return nil, err
+		}
+		event.NewDevice = newDevice
+	}
+
+	return r.ent.LoginEvent.Create().
+		SetNillableUserID(event.UserID).
+		SetType(event.Type).
+		SetMethod(event.Method).
+		SetReason(event.Reason).
+		SetIPAddress(event.IPAddress).
+		SetNetwork(event.Network).
+		SetUserAgent(event.UserAgent).
+		SetDevice(event.Device).
+		SetNewDevice(event.NewDevice).
+		Save(ctx)
+}
+
+// isNewDevice is false on the very first login, since there is nothing to
+// compare against and the user is expected to be on a new device.
+func (r *repo) isNewDevice(ctx context.Context, userID uint64, device, network string) (bool, error) {
+	succeeded := []predicate.LoginEvent{
+		loginevent.UserIDEQ(userID),
+		loginevent.TypeEQ(EventLoginSucceeded),
+	}
+
+	loggedInBefore, err := r.ent.LoginEvent.Query().Where(succeeded...).Exist(ctx)
+	if err != nil || !loggedInBefore {
+		return false, err
+	}
+
+	knownDevice, err := r.ent.LoginEvent.Query().
+		Where(append(succeeded, loginevent.DeviceEQ(device))...).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	knownNetwork, err := r.ent.LoginEvent.Query().
+		Where(append(succeeded, loginevent.NetworkEQ(network))...).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return !knownDevice || !knownNetwork, nil
+}
+
+// ListLoginEvents lists a user's login events, newest first.
+func (r *repo) ListLoginEvents(ctx context.Context, userID uint64, f *filter.Filter) ([]*gen.LoginEvent, int, error) {
+	total, err := r.ent.LoginEvent.Query().
+		Where(loginevent.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.ent.LoginEvent.Query().
+		Where(loginevent.UserIDEQ(userID)).
+		Order(loginevent.ByID(entsql.OrderDesc()))
+	if !f.DisablePaging {
+		query.Limit(f.Limit).Offset(f.Offset)
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
 func NewRepo(ent *gen.Client, db *sql.DB, manager *scs.SessionManager) *repo {
+	sessions, _ := manager.CtxStore.(middleware.UserSessionStore)
+
 	return &repo{
-		ent:     ent,
-		db:      db,
-		session: manager,
+		ent:      ent,
+		db:       db,
+		session:  manager,
+		sessions: sessions,
 	}
 }
 
@@ -124,3 +882,8 @@ func generateToken() (string, error) {
 	}
 	return base64.RawURLEncoding.EncodeToString(b), nil
 }
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
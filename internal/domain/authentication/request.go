@@ -1,13 +1,54 @@
 package authentication
 
+import "time"
+
 type RegisterRequest struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
-	Email     string `json:"email"`
-	Password  string `json:"password"`
+	Email     string `json:"email" validate:"required,email,unique_email"`
+	// Password is at least minPasswordLength long.
+	Password string `json:"password" validate:"min=13"`
+	// InvitationCode is required when registration is invite-only.
+	InvitationCode string `json:"invitation_code"`
 }
 
 type LoginRequest struct {
 	Email    string
 	Password string
+	// RememberMe keeps the session across browser restarts for
+	// SESSION_REMEMBER_ME_DURATION, regardless of inactivity.
+	RememberMe bool `json:"remember_me"`
+}
+
+type MagicLinkRequest struct {
+	Email string `json:"email"`
+}
+
+type CreateTokenRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// UpdateProfileRequest is a partial update. Fields left out are not changed.
+type UpdateProfileRequest struct {
+	FirstName  *string `json:"first_name"`
+	MiddleName *string `json:"middle_name"`
+	LastName   *string `json:"last_name"`
+	Email      *string `json:"email"`
+	Password   *string `json:"password"`
+	// CurrentPassword is required to change password.
+	CurrentPassword string `json:"current_password"`
+}
+
+// CreateInvitationRequest creates an invitation valid until ExpiresAt, or for
+// a week if left out, that can be used by MaxUses people, or one if left out.
+type CreateInvitationRequest struct {
+	Roles     []string   `json:"roles"`
+	MaxUses   int        `json:"max_uses"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
 }
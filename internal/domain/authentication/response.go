@@ -1,5 +1,195 @@
 package authentication
 
+import (
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
 type RespondCsrf struct {
 	CsrfToken string `json:"csrf_token"`
 }
+
+type TokenResponse struct {
+	ID         uint64     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedTokenResponse is the only time the plain text token is shown to the user.
+type CreatedTokenResponse struct {
+	TokenResponse
+	Token string `json:"token"`
+}
+
+func TokenResource(token *gen.PersonalAccessToken) TokenResponse {
+	return TokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+func TokenResources(tokens []*gen.PersonalAccessToken) []TokenResponse {
+	resources := make([]TokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		resources = append(resources, TokenResource(token))
+	}
+	return resources
+}
+
+type ProfileResponse struct {
+	ID           uint64     `json:"id"`
+	FirstName    string     `json:"first_name"`
+	MiddleName   string     `json:"middle_name"`
+	LastName     string     `json:"last_name"`
+	Email        string     `json:"email"`
+	VerifiedAt   *time.Time `json:"verified_at"`
+	PendingEmail string     `json:"pending_email,omitempty"`
+}
+
+func ProfileResource(profile *Profile) ProfileResponse {
+	return ProfileResponse{
+		ID:           profile.User.ID,
+		FirstName:    profile.User.FirstName,
+		MiddleName:   profile.User.MiddleName,
+		LastName:     profile.User.LastName,
+		Email:        profile.User.Email,
+		VerifiedAt:   profile.User.VerifiedAt,
+		PendingEmail: profile.PendingEmail,
+	}
+}
+
+type IdentityResponse struct {
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExportResponse struct {
+	ExportedAt  time.Time                `json:"exported_at"`
+	Profile     ProfileResponse          `json:"profile"`
+	Identities  []IdentityResponse       `json:"identities"`
+	Tokens      []TokenResponse          `json:"personal_access_tokens"`
+	Sessions    []middleware.SessionInfo `json:"sessions"`
+	LoginEvents []LoginEventResponse     `json:"login_events"`
+}
+
+func ExportResource(export *Export) ExportResponse {
+	identities := make([]IdentityResponse, 0, len(export.Identities))
+	for _, identity := range export.Identities {
+		identities = append(identities, IdentityResponse{
+			Issuer:    identity.Issuer,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	sessions := export.Sessions
+	if sessions == nil {
+		sessions = []middleware.SessionInfo{}
+	}
+
+	return ExportResponse{
+		ExportedAt:  time.Now(),
+		Profile:     ProfileResource(export.Profile),
+		Identities:  identities,
+		Tokens:      TokenResources(export.Tokens),
+		Sessions:    sessions,
+		LoginEvents: LoginEventResources(export.LoginEvents),
+	}
+}
+
+type LoginEventResponse struct {
+	ID        uint64    `json:"id"`
+	Type      string    `json:"type"`
+	Method    string    `json:"method,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Device    string    `json:"device"`
+	NewDevice bool      `json:"new_device"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func LoginEventResources(events []*gen.LoginEvent) []LoginEventResponse {
+	resources := make([]LoginEventResponse, 0, len(events))
+	for _, event := range events {
+		resources = append(resources, LoginEventResponse{
+			ID:        event.ID,
+			Type:      event.Type,
+			Method:    event.Method,
+			Reason:    event.Reason,
+			IPAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			Device:    event.Device,
+			NewDevice: event.NewDevice,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return resources
+}
+
+type InvitationResponse struct {
+	ID        uint64                  `json:"id"`
+	CreatedBy *uint64                 `json:"created_by"`
+	Roles     []string                `json:"roles"`
+	MaxUses   int                     `json:"max_uses"`
+	Uses      int                     `json:"uses"`
+	ExpiresAt time.Time               `json:"expires_at"`
+	RevokedAt *time.Time              `json:"revoked_at"`
+	CreatedAt time.Time               `json:"created_at"`
+	UsedBy    []InvitationUseResponse `json:"used_by"`
+}
+
+type InvitationUseResponse struct {
+	UserID uint64    `json:"user_id"`
+	Email  string    `json:"email"`
+	UsedAt time.Time `json:"used_at"`
+}
+
+// CreatedInvitationResponse is the only time the plain text code is shown.
+type CreatedInvitationResponse struct {
+	InvitationResponse
+	Code string `json:"code"`
+}
+
+func InvitationResource(invitation Invitation) InvitationResponse {
+	usedBy := make([]InvitationUseResponse, 0, len(invitation.UsedBy))
+	for _, use := range invitation.UsedBy {
+		usedBy = append(usedBy, InvitationUseResponse{
+			UserID: use.UserID,
+			Email:  use.Email,
+			UsedAt: use.UsedAt,
+		})
+	}
+
+	return InvitationResponse{
+		ID:        invitation.ID,
+		CreatedBy: invitation.CreatedBy,
+		Roles:     invitation.Roles,
+		MaxUses:   invitation.MaxUses,
+		Uses:      invitation.Uses,
+		ExpiresAt: invitation.ExpiresAt,
+		RevokedAt: invitation.RevokedAt,
+		CreatedAt: invitation.CreatedAt,
+		UsedBy:    usedBy,
+	}
+}
+
+func InvitationResources(invitations []Invitation) []InvitationResponse {
+	resources := make([]InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		resources = append(resources, InvitationResource(invitation))
+	}
+	return resources
+}
@@ -3,9 +3,7 @@ package handler
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
-	"log"
 	"log/slog"
 	"net/http"
 
@@ -17,6 +15,7 @@ import (
 	"github.com/gmhafiz/go8/internal/middleware"
 	"github.com/gmhafiz/go8/internal/utility/message"
 	"github.com/gmhafiz/go8/internal/utility/param"
+	"github.com/gmhafiz/go8/internal/utility/request"
 	"github.com/gmhafiz/go8/internal/utility/respond"
 	"github.com/gmhafiz/go8/internal/utility/validate"
 )
@@ -35,23 +34,25 @@ func NewHandler(useCase usecase.Author, v *validator.Validate) *Handler {
 
 // Create creates a new author
 // @Summary Create an Author
-// @Description Create an author using JSON payload
-// @Accept json
-// @Produce json
+// @Description Create an author using a JSON, msgpack or CBOR payload
+// @Accept json,application/msgpack,application/cbor
+// @Produce json,application/msgpack,application/cbor
 // @Param Author body author.CreateRequest true "Create an author using the following format"
 // @Success 201 {object} author.GetResponse
 // @Failure 400 {string} Bad Request
+// @Failure 406 {string} Not Acceptable
+// @Failure 415 {string} Unsupported Media Type
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/author [post]
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	var req author.CreateRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+	err := request.Decode(w, r, &req)
 	if err != nil {
 		respond.Error(w, http.StatusBadRequest, err)
 		return
 	}
 
-	errs := validate.Validate(h.validate, req)
+	errs := validate.Validate(h.validate, r, req)
 	if errs != nil {
 		respond.Errors(w, http.StatusBadRequest, errs)
 		return
@@ -59,7 +60,7 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	create, err := h.useCase.Create(r.Context(), &req)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(r.Context(), "creating author", "error", err)
 		if errors.Is(err, sql.ErrNoRows) {
 			respond.Error(w, http.StatusBadRequest, message.ErrBadRequest)
 			return
@@ -68,14 +69,14 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	respond.Json(w, http.StatusCreated, author.Resource(create))
+	respond.Render(w, r, http.StatusCreated, author.Resource(create))
 }
 
 // List will fetch the authors based on given params
 // @Summary Shows all authors
 // @Description Lists all authors. By default, it gets first page with 30 items.
 // @Accept json
-// @Produce json
+// @Produce json,application/msgpack,application/cbor,text/csv
 // @Param page query string false "page number"
 // @Param limit query string false "limit of result"
 // @Param offset query string false "result offset"
@@ -83,6 +84,7 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 // @Param last_name query string false "search by last_name"
 // @Param sort query string false "sort by fields name. E.g. first_name,asc"
 // @Success 200 {object} respond.Standard
+// @Failure 406 {string} Not Acceptable
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/author [get]
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -101,7 +103,7 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	respond.Json(w, http.StatusOK, respond.Standard{
+	respond.Render(w, r, http.StatusOK, respond.Standard{
 		Data: author.Resources(authors),
 		Meta: respond.Meta{
 			Size:  len(authors),
@@ -114,10 +116,11 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 // @Summary Get an Author
 // @Description Get an author by its id.
 // @Accept json
-// @Produce json
+// @Produce json,application/msgpack,application/cbor
 // @Param id path int true "author ID"
 // @Success 200 {object} gen.Author
 // @Failure 400 {string} Bad Request
+// @Failure 406 {string} Not Acceptable
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/author/{id} [get]
 func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
@@ -131,22 +134,24 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.useCase.Read(ctx, authorID)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(ctx, "reading author", "error", err)
 		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	respond.Json(w, http.StatusOK, author.Resource(res))
+	respond.Render(w, r, http.StatusOK, author.Resource(res))
 }
 
 // Update an author
 // @Summary Update an Author
 // @Description Update an author by its model.
-// @Accept json
-// @Produce json
+// @Accept json,application/msgpack,application/cbor
+// @Produce json,application/msgpack,application/cbor
 // @Param Author body author.UpdateRequest true "Author Request"
 // @Success 200 {object} gen.Author
 // @Failure 400 {string} Bad Request
+// @Failure 406 {string} Not Acceptable
+// @Failure 415 {string} Unsupported Media Type
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/author/{id} [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -159,7 +164,7 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	ctx := context.WithValue(r.Context(), middleware.CacheURL, r.URL.String())
 
 	var req author.UpdateRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err = request.Decode(w, r, &req)
 	if err != nil {
 		respond.Error(w, http.StatusBadRequest, err)
 		return
@@ -168,12 +173,12 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 
 	updated, err := h.useCase.Update(ctx, &req)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(ctx, "updating author", "error", err)
 		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	respond.Json(w, http.StatusOK, author.Resource(updated))
+	respond.Render(w, r, http.StatusOK, author.Resource(updated))
 }
 
 // Delete an author by its ID
@@ -196,7 +201,7 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	err = h.useCase.Delete(ctx, id)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(ctx, "deleting author", "error", err)
 		if errors.Is(err, message.ErrNoRecord) {
 			respond.Error(w, http.StatusBadRequest, err)
 			return
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"io"
@@ -13,15 +14,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
 
 	"github.com/gmhafiz/go8/internal/domain/author"
 	"github.com/gmhafiz/go8/internal/domain/author/usecase"
 	"github.com/gmhafiz/go8/internal/domain/book"
 	"github.com/gmhafiz/go8/internal/utility/message"
 	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/internal/utility/validate"
+	validateLib "github.com/gmhafiz/go8/third_party/validate"
 )
 
 var (
@@ -29,7 +33,16 @@ var (
 )
 
 type Errs struct {
-	Message []string `json:"message"`
+	Errors []validate.FieldError `json:"errors"`
+}
+
+// clientError is what a client is told about err, which is hidden when
+// unexpected.
+func clientError(status int, err error) string {
+	if status >= http.StatusInternalServerError {
+		return respond.ErrUnexpected.Error()
+	}
+	return err.Error()
 }
 
 func TestHandler_Create(t *testing.T) {
@@ -113,7 +126,9 @@ func TestHandler_Create(t *testing.T) {
 				},
 				response: &author.GetResponse{},
 				Errs: Errs{
-					Message: []string{"FirstName is required with type string"},
+					Errors: []validate.FieldError{
+						{Field: "first_name", JSONPointer: "/first_name", Rule: "required", Message: "first_name is a required field"},
+					},
 				},
 				status: http.StatusBadRequest,
 			},
@@ -211,7 +226,7 @@ func TestHandler_Create(t *testing.T) {
 			ww := httptest.NewRecorder()
 
 			router := chi.NewRouter()
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.AuthorMock{
 				CreateFunc: func(ctx context.Context, a *author.CreateRequest) (*author.Schema, error) {
@@ -244,20 +259,51 @@ func TestHandler_Create(t *testing.T) {
 					assert.Nil(t, err)
 
 					errStruct := struct {
-						Message string `json:"message"`
+						Detail string `json:"detail"`
 					}{
-						Message: string(b),
+						Detail: string(b),
 					}
 
 					err = json.Unmarshal(b, &errStruct)
 					assert.Nil(t, err)
-					assert.Equal(t, test.want.err.Error(), errStruct.Message)
+					assert.Equal(t, clientError(ww.Code, test.want.err), errStruct.Detail)
 				}
 			}
 		})
 	}
 }
 
+func TestHandler_Create_Translated(t *testing.T) {
+	req := author.CreateRequest{
+		FirstName: "First",
+		LastName:  "Last",
+		Books: []author.Book{
+			{
+				PublishedDate: "07/03/2022",
+				Description:   "Description",
+			},
+		},
+	}
+	var buf bytes.Buffer
+	assert.Nil(t, json.NewEncoder(&buf).Encode(req))
+
+	rr := httptest.NewRequest(http.MethodPost, "/api/v1/author", &buf)
+	rr.Header.Set("Accept-Language", "nl-BE,nl;q=0.9,en;q=0.8")
+	ww := httptest.NewRecorder()
+
+	h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), &usecase.AuthorMock{})
+	h.Create(ww, rr)
+
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+
+	var errs Errs
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&errs))
+	assert.Equal(t, []validate.FieldError{
+		{Field: "title", JSONPointer: "/books/0/title", Rule: "required", Message: "title is een verplicht veld"},
+		{Field: "published_date", JSONPointer: "/books/0/published_date", Rule: "iso8601", Message: "published_date moet een ISO 8601-datum zijn"},
+	}, errs.Errors)
+}
+
 func TestHandler_List(t *testing.T) {
 	type args struct {
 		uri string
@@ -366,7 +412,7 @@ func TestHandler_List(t *testing.T) {
 
 			router := chi.NewRouter()
 
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.AuthorMock{
 				ListFunc: func(ctx context.Context, f *author.Filter) ([]*author.Schema, int, error) {
@@ -392,14 +438,239 @@ func TestHandler_List(t *testing.T) {
 				assert.Nil(t, err)
 
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string `json:"detail"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
-				assert.Equal(t, test.want.error.Error(), errStruct.Message)
+				assert.Equal(t, clientError(ww.Code, test.want.error), errStruct.Detail)
+			}
+		})
+	}
+}
+
+func TestHandler_List_Negotiated(t *testing.T) {
+	authors := []*author.Schema{
+		{ID: 1, FirstName: "First", MiddleName: "Middle", LastName: "Last"},
+		{ID: 2, FirstName: "Second", LastName: "Last, Jr.", Books: []*book.Schema{{ID: 3, Title: "Title"}}},
+	}
+	want := []author.GetResponse{
+		{ID: 1, FirstName: "First", MiddleName: "Middle", LastName: "Last"},
+		{ID: 2, FirstName: "Second", LastName: "Last, Jr.", Books: []*book.Schema{{ID: 3, Title: "Title"}}},
+	}
+
+	type list struct {
+		Data []author.GetResponse `json:"data"`
+		Meta respond.Meta         `json:"meta"`
+	}
+
+	tests := []struct {
+		accept      string
+		contentType string
+		decode      func(t *testing.T, body []byte)
+	}{
+		{
+			accept:      "",
+			contentType: respond.ContentTypeJSON,
+			decode: func(t *testing.T, body []byte) {
+				var got list
+				assert.Nil(t, json.Unmarshal(body, &got))
+				assert.Equal(t, want, got.Data)
+				assert.Equal(t, respond.Meta{Size: 2, Total: 2}, got.Meta)
+			},
+		},
+		{
+			accept:      "application/x-msgpack",
+			contentType: respond.ContentTypeMsgpack,
+			decode: func(t *testing.T, body []byte) {
+				dec := msgpack.NewDecoder(bytes.NewReader(body))
+				dec.SetCustomStructTag("json")
+				var got list
+				assert.Nil(t, dec.Decode(&got))
+				assert.Equal(t, want, got.Data)
+				assert.Equal(t, respond.Meta{Size: 2, Total: 2}, got.Meta)
+			},
+		},
+		{
+			accept:      "application/json;q=0.5, application/cbor",
+			contentType: respond.ContentTypeCBOR,
+			decode: func(t *testing.T, body []byte) {
+				var got list
+				assert.Nil(t, cbor.Unmarshal(body, &got))
+				assert.Equal(t, want, got.Data)
+				assert.Equal(t, respond.Meta{Size: 2, Total: 2}, got.Meta)
+			},
+		},
+		{
+			accept:      "*/*;q=0.1, text/csv",
+			contentType: respond.ContentTypeCSV + "; charset=utf-8",
+			decode: func(t *testing.T, body []byte) {
+				records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+				assert.Nil(t, err)
+
+				// Nested lists are written as they are in JSON.
+				books, err := json.Marshal(want[1].Books)
+				assert.Nil(t, err)
+
+				assert.Equal(t, [][]string{
+					{"id", "first_name", "middle_name", "last_name", "books"},
+					{"1", "First", "Middle", "Last", ""},
+					{"2", "Second", "", "Last, Jr.", string(books)},
+				}, records)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.accept, func(t *testing.T) {
+			rr := httptest.NewRequest(http.MethodGet, "/api/v1/author", nil)
+			rr.Header.Set("Accept", test.accept)
+			ww := httptest.NewRecorder()
+			// As a middleware varying on Accept would have.
+			ww.Header().Add("Vary", "accept")
+
+			uc := &usecase.AuthorMock{
+				ListFunc: func(ctx context.Context, f *author.Filter) ([]*author.Schema, int, error) {
+					return authors, len(authors), nil
+				},
+			}
+
+			h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), uc)
+			h.List(ww, rr)
+
+			assert.Equal(t, http.StatusOK, ww.Code)
+			assert.Equal(t, test.contentType, ww.Header().Get("Content-Type"))
+			assert.Equal(t, []string{"accept"}, ww.Header().Values("Vary"), "expected Vary not to repeat Accept")
+			test.decode(t, ww.Body.Bytes())
+		})
+	}
+
+	t.Run("not acceptable", func(t *testing.T) {
+		rr := httptest.NewRequest(http.MethodGet, "/api/v1/author/1", nil)
+		rr.Header.Set("Accept", "text/csv, application/xml")
+		ww := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "1")
+		rr = rr.WithContext(context.WithValue(rr.Context(), chi.RouteCtxKey, rctx))
+
+		uc := &usecase.AuthorMock{
+			ReadFunc: func(ctx context.Context, authorID uint64) (*author.Schema, error) {
+				return authors[0], nil
+			},
+		}
+
+		h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), uc)
+		h.Get(ww, rr)
+
+		assert.Equal(t, http.StatusNotAcceptable, ww.Code)
+		assert.Equal(t, respond.ContentTypeProblem, ww.Header().Get("Content-Type"))
+	})
+}
+
+func TestHandler_Create_Decoded(t *testing.T) {
+	req := author.CreateRequest{
+		FirstName: "First",
+		LastName:  "Last",
+	}
+
+	msgpackBody := func(v any) []byte {
+		var buf bytes.Buffer
+		enc := msgpack.NewEncoder(&buf)
+		enc.SetCustomStructTag("json")
+		if err := enc.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	cborBody := func(v any) []byte {
+		b, err := cbor.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		status      int
+		detail      string
+	}{
+		{
+			name:        "msgpack",
+			contentType: "application/msgpack",
+			body:        msgpackBody(req),
+			status:      http.StatusCreated,
+		},
+		{
+			name:        "cbor",
+			contentType: "application/cbor",
+			body:        cborBody(req),
+			status:      http.StatusCreated,
+		},
+		{
+			name:        "msgpack with an unknown key",
+			contentType: "application/vnd.msgpack",
+			body:        msgpackBody(map[string]string{"first_name": "First", "last_name": "Last", "role": "admin"}),
+			status:      http.StatusBadRequest,
+			detail:      "body contains an unknown key",
+		},
+		{
+			name:        "cbor with an unknown key",
+			contentType: "application/cbor",
+			body:        cborBody(map[string]string{"first_name": "First", "role": "admin"}),
+			status:      http.StatusBadRequest,
+			detail:      "body contains an unknown key",
+		},
+		{
+			name:        "cbor with more than one value",
+			contentType: "application/cbor",
+			body:        append(cborBody(req), cborBody(req)...),
+			status:      http.StatusBadRequest,
+			detail:      "body must only contain a single CBOR value",
+		},
+		{
+			name:        "json sent as a form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        []byte(`{"first_name": "First", "last_name": "Last"}`),
+			status:      http.StatusCreated,
+		},
+		{
+			name:        "unsupported media type",
+			contentType: "application/xml",
+			body:        []byte("<author/>"),
+			status:      http.StatusUnsupportedMediaType,
+			detail:      "body must be application/json, application/msgpack or application/cbor",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := httptest.NewRequest(http.MethodPost, "/api/v1/author", bytes.NewReader(test.body))
+			rr.Header.Set("Content-Type", test.contentType)
+			ww := httptest.NewRecorder()
+
+			uc := &usecase.AuthorMock{
+				CreateFunc: func(ctx context.Context, a *author.CreateRequest) (*author.Schema, error) {
+					assert.Equal(t, req, *a)
+					return &author.Schema{ID: 1, FirstName: a.FirstName, LastName: a.LastName}, nil
+				},
+			}
+
+			h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), uc)
+			h.Create(ww, rr)
+
+			assert.Equal(t, test.status, ww.Code)
+			if test.detail != "" {
+				var problem struct {
+					Detail string `json:"detail"`
+				}
+				assert.Nil(t, json.Unmarshal(ww.Body.Bytes(), &problem))
+				assert.Equal(t, test.detail, problem.Detail)
 			}
 		})
 	}
@@ -487,7 +758,7 @@ func TestHandler_Read(t *testing.T) {
 
 			router := chi.NewRouter()
 
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.AuthorMock{
 				ReadFunc: func(ctx context.Context, authorID uint64) (*author.Schema, error) {
@@ -513,14 +784,14 @@ func TestHandler_Read(t *testing.T) {
 				assert.Nil(t, err)
 
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string `json:"detail"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
-				assert.Equal(t, test.want.err.Error(), errStruct.Message)
+				assert.Equal(t, clientError(ww.Code, test.want.err), errStruct.Detail)
 			}
 
 		})
@@ -628,7 +899,7 @@ func TestHandler_Update(t *testing.T) {
 
 			router := chi.NewRouter()
 
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.AuthorMock{
 				UpdateFunc: func(ctx context.Context, author *author.UpdateRequest) (*author.Schema, error) {
@@ -652,14 +923,14 @@ func TestHandler_Update(t *testing.T) {
 				assert.Nil(t, err)
 
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string `json:"detail"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
-				assert.Equal(t, test.want.err.Error(), errStruct.Message)
+				assert.Equal(t, clientError(ww.Code, test.want.err), errStruct.Detail)
 			}
 
 		})
@@ -737,7 +1008,7 @@ func TestHandler_Delete(t *testing.T) {
 			rr = rr.WithContext(context.WithValue(rr.Context(), chi.RouteCtxKey, rctx))
 
 			router := chi.NewRouter()
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.AuthorMock{
 				DeleteFunc: func(ctx context.Context, authorID uint64) error {
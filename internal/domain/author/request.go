@@ -4,13 +4,13 @@ type CreateRequest struct {
 	FirstName  string `json:"first_name" validate:"required"`
 	MiddleName string `json:"middle_name"`
 	LastName   string `json:"last_name" validate:"required"`
-	Books      []Book `json:"books"`
+	Books      []Book `json:"books" validate:"dive"`
 }
 
 type Book struct {
 	BookID        uint64 `json:"id"`
 	Title         string `json:"title" validate:"required"`
-	PublishedDate string `json:"published_date" validate:"required"`
+	PublishedDate string `json:"published_date" validate:"required,iso8601"`
 	Description   string `json:"description" validate:"required"`
 }
 
@@ -1,9 +1,7 @@
 package handler
 
 import (
-	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -13,6 +11,7 @@ import (
 	"github.com/gmhafiz/go8/internal/domain/book/usecase"
 	"github.com/gmhafiz/go8/internal/utility/message"
 	"github.com/gmhafiz/go8/internal/utility/param"
+	"github.com/gmhafiz/go8/internal/utility/request"
 	"github.com/gmhafiz/go8/internal/utility/respond"
 	"github.com/gmhafiz/go8/internal/utility/validate"
 )
@@ -31,23 +30,25 @@ func NewHandler(useCase usecase.Book, validate *validator.Validate) *Handler {
 
 // Create creates a new book record
 // @Summary Create a Book
-// @Description Create a book using JSON payload
-// @Accept json
-// @Produce json
+// @Description Create a book using a JSON, msgpack or CBOR payload
+// @Accept json,application/msgpack,application/cbor
+// @Produce json,application/msgpack,application/cbor
 // @Param Book body book.CreateRequest true "Create a book using the following format"
 // @Success 201 {object} book.Res
 // @Failure 400 {string} Bad book.CreateRequest
+// @Failure 406 {string} Not Acceptable
+// @Failure 415 {string} Unsupported Media Type
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/book [post]
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	var bookRequest book.CreateRequest
-	err := json.NewDecoder(r.Body).Decode(&bookRequest)
+	err := request.Decode(w, r, &bookRequest)
 	if err != nil {
-		respond.Error(w, http.StatusBadRequest, nil)
+		respond.Error(w, http.StatusBadRequest, err)
 		return
 	}
 
-	errs := validate.Validate(h.validate, bookRequest)
+	errs := validate.Validate(h.validate, r, bookRequest)
 	if errs != nil {
 		respond.Errors(w, http.StatusBadRequest, errs)
 		return
@@ -65,17 +66,18 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	b := book.Resource(bk)
 
-	respond.Json(w, http.StatusCreated, b)
+	respond.Render(w, r, http.StatusCreated, b)
 }
 
 // Get a book by its ID
 // @Summary Get a Book
 // @Description Get a book by its id.
 // @Accept json
-// @Produce json
+// @Produce json,application/msgpack,application/cbor
 // @Param bookID path int true "book ID"
 // @Success 200 {object} book.Res
 // @Failure 400 {string} Bad book.CreateRequest
+// @Failure 406 {string} Not Acceptable
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/book/{bookID} [get]
 func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
@@ -85,7 +87,7 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	b, err := h.useCase.Read(context.Background(), bookID)
+	b, err := h.useCase.Read(r.Context(), bookID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			respond.Error(w, http.StatusBadRequest, errors.New("no book is found for this ID"))
@@ -96,19 +98,20 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 	}
 	list := book.Resource(b)
 
-	respond.Json(w, http.StatusOK, list)
+	respond.Render(w, r, http.StatusOK, list)
 }
 
 // List will fetch the article based on given params
 // @Summary Shows all books
 // @Description Lists all books. By default, it gets first page with 30 items.
 // @Accept json
-// @Produce json
+// @Produce json,application/msgpack,application/cbor,text/csv
 // @Param page query string false "page number"
 // @Param size query string false "size of result"
 // @Param title query string false "search by title"
 // @Param description query string false "search by description"
 // @Success 200 {object} []book.Res
+// @Failure 406 {string} Not Acceptable
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/book [get]
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -148,17 +151,19 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	respond.Json(w, http.StatusOK, list)
+	respond.Render(w, r, http.StatusOK, list)
 }
 
 // Update a book
 // @Summary Update a Book
 // @Description Update a book by its model.
-// @Accept json
-// @Produce json
+// @Accept json,application/msgpack,application/cbor
+// @Produce json,application/msgpack,application/cbor
 // @Param Book body book.UpdateRequest true "Book UpdateRequest"
 // @Success 200 {object} book.Res
 // @Failure 400 {string} Bad Request
+// @Failure 406 {string} Not Acceptable
+// @Failure 415 {string} Unsupported Media Type
 // @Failure 500 {string} Internal Server Error
 // @router /api/v1/book/{bookID} [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -169,14 +174,14 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req book.UpdateRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err = request.Decode(w, r, &req)
 	if err != nil {
-		respond.Error(w, http.StatusBadRequest, nil)
+		respond.Error(w, http.StatusBadRequest, err)
 		return
 	}
 	req.ID = bookID
 
-	errs := validate.Validate(h.validate, req)
+	errs := validate.Validate(h.validate, r, req)
 	if errs != nil {
 		respond.Errors(w, http.StatusBadRequest, errs)
 		return
@@ -190,7 +195,7 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 
 	res := book.Resource(resp)
 
-	respond.Json(w, http.StatusOK, res)
+	respond.Render(w, r, http.StatusOK, res)
 }
 
 // Delete a book by its ID
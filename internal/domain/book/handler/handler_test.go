@@ -21,10 +21,22 @@ import (
 	"github.com/gmhafiz/go8/internal/domain/book"
 	"github.com/gmhafiz/go8/internal/domain/book/usecase"
 	"github.com/gmhafiz/go8/internal/utility/message"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/internal/utility/validate"
+	validateLib "github.com/gmhafiz/go8/third_party/validate"
 )
 
 type Errs struct {
-	Message []string `json:"message"`
+	Errors []validate.FieldError `json:"errors"`
+}
+
+// clientError is what a client is told about err, which is hidden when
+// unexpected.
+func clientError(status int, err error) string {
+	if status >= http.StatusInternalServerError {
+		return respond.ErrUnexpected.Error()
+	}
+	return err.Error()
 }
 
 func TestHandler_Create(t *testing.T) {
@@ -68,7 +80,7 @@ func TestHandler_Create(t *testing.T) {
 					Description:   "Test Description",
 				},
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -104,7 +116,7 @@ func TestHandler_Create(t *testing.T) {
 					PublishedDate: "2022-03-07T00:00:00Z",
 				},
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -115,10 +127,10 @@ func TestHandler_Create(t *testing.T) {
 					err:  nil,
 				},
 				res: &book.Res{},
-				errs: Errs{Message: []string{
-					"Title is required with type string",
-					"ImageURL is url with type string",
-					"Description is required with type string",
+				errs: Errs{Errors: []validate.FieldError{
+					{Field: "title", JSONPointer: "/title", Rule: "required", Message: "title is a required field"},
+					{Field: "image_url", JSONPointer: "/image_url", Rule: "url", Message: "image_url must be a valid URL"},
+					{Field: "description", JSONPointer: "/description", Rule: "required", Message: "description is a required field"},
 				}},
 				status: http.StatusBadRequest,
 			},
@@ -133,7 +145,7 @@ func TestHandler_Create(t *testing.T) {
 					Description:   "Description",
 				},
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -158,7 +170,7 @@ func TestHandler_Create(t *testing.T) {
 					Description:   "Description",
 				},
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -225,26 +237,26 @@ func TestHandler_Create(t *testing.T) {
 				b, err := io.ReadAll(ww.Body)
 				assert.Nil(t, err)
 
-				if len(tt.want.errs.Message) > 0 {
+				if len(tt.want.errs.Errors) > 0 {
 					errStruct := Errs{}
 
 					err = json.Unmarshal(b, &errStruct)
 					assert.Nil(t, err)
 
-					for i := range errStruct.Message {
-						assert.Equal(t, tt.want.errs.Message[i], errStruct.Message[i])
+					for i := range errStruct.Errors {
+						assert.Equal(t, tt.want.errs.Errors[i], errStruct.Errors[i])
 					}
 
 				} else {
 					errStruct := struct {
-						Message string `json:"message"`
+						Detail string `json:"detail"`
 					}{
-						Message: string(b),
+						Detail: string(b),
 					}
 
 					err = json.Unmarshal(b, &errStruct)
 					assert.Nil(t, err)
-					assert.Equal(t, tt.want.err.Error(), errStruct.Message)
+					assert.Equal(t, clientError(ww.Code, tt.want.err), errStruct.Detail)
 				}
 			}
 
@@ -282,7 +294,7 @@ func TestHandler_Get(t *testing.T) {
 				bookID:    1,
 				param:     "bookID",
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -307,7 +319,7 @@ func TestHandler_Get(t *testing.T) {
 				bookID:    1,
 				param:     "id",
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -328,7 +340,7 @@ func TestHandler_Get(t *testing.T) {
 				bookID:    1,
 				param:     "bookID",
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -349,7 +361,7 @@ func TestHandler_Get(t *testing.T) {
 				bookID:    1,
 				param:     "bookID",
 				router:    chi.NewRouter(),
-				validator: validator.New(),
+				validator: validateLib.New(),
 			},
 			want: want{
 				usecase: struct {
@@ -404,9 +416,9 @@ func TestHandler_Get(t *testing.T) {
 				assert.Nil(t, err)
 
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string `json:"detail"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				if len(b) == 0 {
@@ -415,7 +427,7 @@ func TestHandler_Get(t *testing.T) {
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
-				assert.Equal(t, tt.want.err, errors.New(errStruct.Message))
+				assert.Equal(t, clientError(ww.Code, tt.want.err), errStruct.Detail)
 			}
 		})
 	}
@@ -535,7 +547,7 @@ func TestHandler_List(t *testing.T) {
 				},
 			}
 
-			h := RegisterHTTPEndPoints(chi.NewRouter(), validator.New(), uc)
+			h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), uc)
 
 			h.List(ww, rr)
 
@@ -563,14 +575,14 @@ func TestHandler_List(t *testing.T) {
 				assert.Nil(t, err)
 
 				errStruct := struct {
-					Message string `json:"message"`
+					Detail string `json:"detail"`
 				}{
-					Message: string(b),
+					Detail: string(b),
 				}
 
 				err = json.Unmarshal(b, &errStruct)
 				assert.Nil(t, err)
-				assert.Equal(t, tt.want.err.Error(), errStruct.Message)
+				assert.Equal(t, clientError(ww.Code, tt.want.err), errStruct.Detail)
 			}
 
 		})
@@ -686,10 +698,10 @@ func TestHandler_Update(t *testing.T) {
 				},
 				status: http.StatusBadRequest,
 				book:   &book.Res{},
-				errs: Errs{Message: []string{
-					"PublishedDate is required with type string",
-					"ImageURL is url with type string",
-					"Description is required with type string",
+				errs: Errs{Errors: []validate.FieldError{
+					{Field: "published_date", JSONPointer: "/published_date", Rule: "required", Message: "published_date is a required field"},
+					{Field: "image_url", JSONPointer: "/image_url", Rule: "url", Message: "image_url must be a valid URL"},
+					{Field: "description", JSONPointer: "/description", Rule: "required", Message: "description is a required field"},
 				}},
 			},
 		},
@@ -747,7 +759,7 @@ func TestHandler_Update(t *testing.T) {
 				},
 			}
 
-			h := RegisterHTTPEndPoints(chi.NewRouter(), validator.New(), uc)
+			h := RegisterHTTPEndPoints(chi.NewRouter(), validateLib.New(), uc)
 
 			h.Update(ww, rr)
 
@@ -770,26 +782,26 @@ func TestHandler_Update(t *testing.T) {
 				b, err := io.ReadAll(ww.Body)
 				assert.Nil(t, err)
 
-				if len(tt.want.errs.Message) > 0 {
+				if len(tt.want.errs.Errors) > 0 {
 					errStruct := Errs{}
 
 					err = json.Unmarshal(b, &errStruct)
 					assert.Nil(t, err)
 
-					for i := range errStruct.Message {
-						assert.Equal(t, tt.want.errs.Message[i], errStruct.Message[i])
+					for i := range errStruct.Errors {
+						assert.Equal(t, tt.want.errs.Errors[i], errStruct.Errors[i])
 					}
 
 				} else {
 					errStruct := struct {
-						Message string `json:"message"`
+						Detail string `json:"detail"`
 					}{
-						Message: string(b),
+						Detail: string(b),
 					}
 
 					err = json.Unmarshal(b, &errStruct)
 					assert.Nil(t, err)
-					assert.Equal(t, tt.want.err.Error(), errStruct.Message)
+					assert.Equal(t, clientError(ww.Code, tt.want.err), errStruct.Detail)
 				}
 
 			}
@@ -858,7 +870,7 @@ func TestHandler_Delete(t *testing.T) {
 			rr = rr.WithContext(context.WithValue(rr.Context(), chi.RouteCtxKey, rctx))
 
 			router := chi.NewRouter()
-			val := validator.New()
+			val := validateLib.New()
 
 			uc := &usecase.BookMock{
 				DeleteFunc: func(ctx context.Context, bookID uint64) error {
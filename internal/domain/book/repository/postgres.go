@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
 	"github.com/jmoiron/sqlx"
 
+	"github.com/gmhafiz/go8/internal/domain/audit"
 	"github.com/gmhafiz/go8/internal/domain/book"
+	"github.com/gmhafiz/go8/internal/middleware"
 	"github.com/gmhafiz/go8/internal/utility/message"
 )
 
@@ -34,6 +38,9 @@ const (
 	DeleteByID              = "DELETE FROM books where id = ($1) RETURNING id"
 	SearchBooks             = "SELECT * FROM books where title like '%' || $1 || '%' and description like '%'|| $2 || '%' ORDER BY published_date DESC"
 	SearchBooksPaginate     = "SELECT * FROM books where title like '%' || '%' || $1 || '%' || '%' and description like '%'|| $2 || '%' ORDER BY published_date DESC LIMIT $3 OFFSET $4"
+	SelectBookByIDForUpdate = "SELECT * FROM books where id = $1 FOR UPDATE"
+
+	booksTable = "books"
 )
 
 func New(db *sqlx.DB) *bookRepository {
@@ -41,11 +48,25 @@ func New(db *sqlx.DB) *bookRepository {
 }
 
 func (r *bookRepository) Create(ctx context.Context, req *book.CreateRequest) (bookID uint64, err error) {
-	if err = r.db.QueryRowContext(ctx, InsertIntoBooks, req.Title, req.PublishedDate, req.ImageURL, req.Description).Scan(&bookID); err != nil {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err = tx.QueryRowContext(ctx, InsertIntoBooks, req.Title, req.PublishedDate, req.ImageURL, req.Description).Scan(&bookID); err != nil {
 		return 0, errors.New("repository.Book.Create")
 	}
 
-	return bookID, nil
+	var created book.Schema
+	if err = tx.GetContext(ctx, &created, SelectBookByID, bookID); err != nil {
+		return 0, err
+	}
+	if err = audit.RecordChange(ctx, tx, booksTable, bookID, middleware.ActionCreate, nil, values(&created)); err != nil {
+		return 0, err
+	}
+
+	return bookID, tx.Commit()
 }
 
 func (r *bookRepository) List(ctx context.Context, f *book.Filter) ([]*book.Schema, error) {
@@ -84,9 +105,19 @@ func (r *bookRepository) Read(ctx context.Context, bookID uint64) (*book.Schema,
 }
 
 func (r *bookRepository) Update(ctx context.Context, book *book.UpdateRequest) error {
-	var returnedID int
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	old, err := lockBook(ctx, tx, book.ID)
+	if err != nil {
+		return err
+	}
 
-	err := r.db.QueryRowContext(ctx, UpdateBook,
+	var returnedID int
+	err = tx.QueryRowContext(ctx, UpdateBook,
 		book.Title,
 		book.Description,
 		book.PublishedDate,
@@ -97,17 +128,40 @@ func (r *bookRepository) Update(ctx context.Context, book *book.UpdateRequest) e
 		return err
 	}
 
-	return nil
+	updated, err := lockBook(ctx, tx, book.ID)
+	if err != nil {
+		return err
+	}
+	if err := audit.RecordChange(ctx, tx, booksTable, book.ID, middleware.ActionUpdate, values(old), values(updated)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *bookRepository) Delete(ctx context.Context, bookID uint64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	old, err := lockBook(ctx, tx, bookID)
+	if err != nil {
+		return fmt.Errorf("ID not found: %w", err)
+	}
+
 	var returnedID int
-	err := r.db.QueryRowContext(ctx, DeleteByID, bookID).Scan(&returnedID)
+	err = tx.QueryRowContext(ctx, DeleteByID, bookID).Scan(&returnedID)
 	if err != nil {
 		return fmt.Errorf("ID not found: %w", err)
 	}
 
-	return nil
+	if err := audit.RecordChange(ctx, tx, booksTable, bookID, middleware.ActionDelete, values(old), nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *bookRepository) Search(ctx context.Context, f *book.Filter) ([]*book.Schema, error) {
@@ -127,3 +181,33 @@ func (r *bookRepository) Search(ctx context.Context, f *book.Filter) ([]*book.Sc
 
 	return books, nil
 }
+
+// lockBook reads a book within tx, holding it until tx ends so that its audited
+// old values cannot change underneath.
+func lockBook(ctx context.Context, tx *sqlx.Tx, bookID uint64) (*book.Schema, error) {
+	var b book.Schema
+	if err := tx.GetContext(ctx, &b, SelectBookByIDForUpdate, bookID); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// values keys a book by its columns for the audit log, the same way ent-backed
+// entities are recorded.
+func values(b *book.Schema) map[string]any {
+	var deletedAt *time.Time
+	if b.DeletedAt.Valid {
+		deletedAt = &b.DeletedAt.Time
+	}
+
+	return map[string]any{
+		"id":             b.ID,
+		"title":          b.Title,
+		"published_date": b.PublishedDate,
+		"image_url":      b.ImageURL,
+		"description":    b.Description,
+		"created_at":     b.CreatedAt,
+		"updated_at":     b.UpdatedAt,
+		"deleted_at":     deletedAt,
+	}
+}
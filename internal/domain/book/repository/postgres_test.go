@@ -3,14 +3,19 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"math"
+	"net/http"
 	"os"
 	"testing"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/go8/ent/gen"
 	_ "github.com/gmhafiz/go8/ent/gen/runtime"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -19,7 +24,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gmhafiz/go8/database"
+	"github.com/gmhafiz/go8/internal/domain/audit"
 	"github.com/gmhafiz/go8/internal/domain/book"
+	"github.com/gmhafiz/go8/internal/middleware"
 	"github.com/gmhafiz/go8/internal/utility/filter"
 	"github.com/gmhafiz/go8/internal/utility/message"
 )
@@ -663,6 +670,191 @@ func TestRepository_Search(t *testing.T) {
 	}
 }
 
+func TestRepository_Audit(t *testing.T) {
+	client := sqlxDBClient(migrator.DB)
+	repo := New(client)
+
+	actorID := uint64(42)
+	ctx := context.WithValue(context.Background(), middleware.KeyAuditID, middleware.Event{
+		HTTPMethod: http.MethodPost,
+		URL:        "/api/v1/book",
+	})
+	ctx = context.WithValue(ctx, middleware.KeyID, actorID)
+
+	bookID, err := repo.Create(ctx, &book.CreateRequest{
+		Title:         "audited",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.Nil(t, err)
+
+	err = repo.Update(ctx, &book.UpdateRequest{
+		ID:            bookID,
+		Title:         "audited again",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.Nil(t, err)
+
+	err = repo.Delete(ctx, bookID)
+	assert.Nil(t, err)
+
+	logs := auditLogs(t, client, bookID)
+	assert.Len(t, logs, 3)
+
+	for _, l := range logs {
+		assert.Equal(t, actorID, *l.ActorID)
+		assert.Equal(t, http.MethodPost, l.HTTPMethod)
+	}
+
+	assert.Equal(t, "create", logs[0].Action)
+	assert.Nil(t, logs[0].OldValues)
+	assert.Equal(t, "audited", logs[0].NewValues["title"])
+
+	assert.Equal(t, "update", logs[1].Action)
+	assert.Equal(t, "audited", logs[1].OldValues["title"])
+	assert.Equal(t, "audited again", logs[1].NewValues["title"])
+	// Unchanged columns are left out.
+	assert.NotContains(t, logs[1].NewValues, "description")
+
+	assert.Equal(t, "delete", logs[2].Action)
+	assert.Equal(t, "audited again", logs[2].OldValues["title"])
+	assert.Nil(t, logs[2].NewValues)
+}
+
+func TestRepository_AuditChain(t *testing.T) {
+	client := sqlxDBClient(migrator.DB)
+	repo := New(client)
+	entClient := gen.NewClient(gen.Driver(entsql.OpenDB(dialect.Postgres, migrator.DB)))
+
+	ctx := context.WithValue(context.Background(), middleware.KeyAuditID, middleware.Event{})
+
+	bookID, err := repo.Create(ctx, &book.CreateRequest{
+		Title:         "chained",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.Nil(t, err)
+	err = repo.Delete(ctx, bookID)
+	assert.Nil(t, err)
+
+	// Hashes computed by Postgres must match the ones checked in Go.
+	result, err := audit.Verify(context.Background(), entClient, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Break)
+	assert.GreaterOrEqual(t, result.Entries, 2)
+
+	var id uint64
+	err = client.Get(&id, `SELECT min(id) FROM audit_logs WHERE table_name = 'books' AND table_row_id = $1`, bookID)
+	assert.Nil(t, err)
+	_, err = client.Exec(`UPDATE audit_logs SET new_values = '{"title":"edited"}' WHERE id = $1`, id)
+	assert.Nil(t, err)
+
+	result, err = audit.Verify(context.Background(), entClient, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Break)
+	assert.Equal(t, id, result.Break.LogID)
+}
+
+func TestRepository_AuditRollsBackWithChange(t *testing.T) {
+	client := sqlxDBClient(migrator.DB)
+	repo := New(client)
+
+	ctx := context.WithValue(context.Background(), middleware.KeyAuditID, middleware.Event{})
+
+	bookID, err := repo.Create(ctx, &book.CreateRequest{
+		Title:         "before",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.Nil(t, err)
+
+	// Makes saving an audit log of a book fail from now on.
+	_, err = client.Exec(`ALTER TABLE audit_logs ADD CONSTRAINT reject_books CHECK (table_name <> 'books') NOT VALID`)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		_, _ = client.Exec(`ALTER TABLE audit_logs DROP CONSTRAINT reject_books`)
+	})
+
+	_, err = repo.Create(ctx, &book.CreateRequest{
+		Title:         "never created",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.NotNil(t, err)
+
+	var count int
+	err = client.Get(&count, `SELECT count(*) FROM books WHERE title = 'never created'`)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	err = repo.Update(ctx, &book.UpdateRequest{
+		ID:            bookID,
+		Title:         "after",
+		PublishedDate: "2020-01-01T15:04:05Z",
+		ImageURL:      "https://example.com/image.png",
+		Description:   "description",
+	})
+	assert.NotNil(t, err)
+
+	got, err := repo.Read(ctx, bookID)
+	assert.Nil(t, err)
+	assert.Equal(t, "before", got.Title)
+
+	err = repo.Delete(ctx, bookID)
+	assert.NotNil(t, err)
+
+	_, err = repo.Read(ctx, bookID)
+	assert.Nil(t, err)
+
+	// Only the first creation was recorded.
+	assert.Len(t, auditLogs(t, client, bookID), 1)
+}
+
+type auditLog struct {
+	ActorID    *uint64
+	Action     string
+	HTTPMethod string
+	OldValues  map[string]any
+	NewValues  map[string]any
+}
+
+func auditLogs(t *testing.T, db *sqlx.DB, bookID uint64) []auditLog {
+	t.Helper()
+
+	rows, err := db.Query(`
+		SELECT actor_id, action, http_method, old_values, new_values
+		FROM audit_logs
+		WHERE table_name = 'books' AND table_row_id = $1
+		ORDER BY id`, bookID)
+	assert.Nil(t, err)
+	defer rows.Close()
+
+	var logs []auditLog
+	for rows.Next() {
+		var l auditLog
+		var oldValues, newValues []byte
+		err := rows.Scan(&l.ActorID, &l.Action, &l.HTTPMethod, &oldValues, &newValues)
+		assert.Nil(t, err)
+
+		if oldValues != nil {
+			assert.Nil(t, json.Unmarshal(oldValues, &l.OldValues))
+		}
+		if newValues != nil {
+			assert.Nil(t, json.Unmarshal(newValues, &l.NewValues))
+		}
+		logs = append(logs, l)
+	}
+	assert.Nil(t, rows.Err())
+
+	return logs
+}
+
 func sqlxDBClient(db *sql.DB) *sqlx.DB {
 	return sqlx.NewDb(db, DBDriver)
 }
@@ -2,7 +2,7 @@ package book
 
 type CreateRequest struct {
 	Title         string `json:"title" validate:"required"`
-	PublishedDate string `json:"published_date" validate:"required"`
+	PublishedDate string `json:"published_date" validate:"required,iso8601"`
 	ImageURL      string `json:"image_url" validate:"url"`
 	Description   string `json:"description" validate:"required"`
 }
@@ -10,7 +10,7 @@ type CreateRequest struct {
 type UpdateRequest struct {
 	ID            uint64 `json:"-"`
 	Title         string `json:"title" validate:"required"`
-	PublishedDate string `json:"published_date" validate:"required"`
+	PublishedDate string `json:"published_date" validate:"required,iso8601"`
 	ImageURL      string `json:"image_url" validate:"url"`
 	Description   string `json:"description" validate:"required"`
 }
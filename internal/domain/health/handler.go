@@ -32,8 +32,8 @@ func (h *Handler) Health(w http.ResponseWriter, _ *http.Request) {
 // @Success 200
 // @Failure 500
 // @router /api/health/readiness [get]
-func (h *Handler) Readiness(w http.ResponseWriter, _ *http.Request) {
-	err := h.useCase.Readiness()
+func (h *Handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	err := h.useCase.Readiness(r.Context())
 	if err != nil {
 		respond.Error(w, http.StatusInternalServerError, err)
 		return
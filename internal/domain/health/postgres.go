@@ -1,11 +1,13 @@
 package health
 
 import (
+	"context"
+
 	"github.com/jmoiron/sqlx"
 )
 
 type Repository interface {
-	Readiness() error
+	Readiness(ctx context.Context) error
 }
 
 type repository struct {
@@ -18,6 +20,6 @@ func NewRepo(db *sqlx.DB) *repository {
 	}
 }
 
-func (r *repository) Readiness() error {
-	return r.db.Ping()
+func (r *repository) Readiness(ctx context.Context) error {
+	return r.db.PingContext(ctx)
 }
@@ -1,11 +1,17 @@
 package health
 
 import (
+	"time"
+
 	"github.com/go-chi/chi/v5"
 
 	"github.com/gmhafiz/go8/internal/middleware"
 )
 
+// readinessTimeout is short, so that an unreachable database fails the
+// probe instead of holding it until the orchestrator gives up.
+const readinessTimeout = 3 * time.Second
+
 func RegisterHTTPEndPoints(router *chi.Mux, uc UseCase) *Handler {
 	h := NewHandler(uc)
 
@@ -13,7 +19,7 @@ func RegisterHTTPEndPoints(router *chi.Mux, uc UseCase) *Handler {
 		router.Use(middleware.Json)
 
 		router.Get("/", h.Health)
-		router.Get("/readiness", h.Readiness)
+		router.With(middleware.Timeout(readinessTimeout)).Get("/readiness", h.Readiness)
 	})
 
 	return h
@@ -1,7 +1,9 @@
 package health
 
+import "context"
+
 type UseCase interface {
-	Readiness() error
+	Readiness(ctx context.Context) error
 }
 
 type Health struct {
@@ -14,6 +16,6 @@ func New(health Repository) *Health {
 	}
 }
 
-func (u *Health) Readiness() error {
-	return u.healthRepo.Readiness()
+func (u *Health) Readiness(ctx context.Context) error {
+	return u.healthRepo.Readiness(ctx)
 }
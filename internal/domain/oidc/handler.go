@@ -0,0 +1,173 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"golang.org/x/oauth2"
+
+	"github.com/gmhafiz/go8/config"
+	"github.com/gmhafiz/go8/internal/domain/authentication"
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+const (
+	keyState    = "oidc_state"
+	keyNonce    = "oidc_nonce"
+	keyVerifier = "oidc_verifier"
+)
+
+var (
+	ErrNoIDToken    = errors.New("no id_token in token response")
+	ErrInvalidState = errors.New("invalid oidc state")
+	ErrInvalidNonce = errors.New("invalid oidc nonce")
+)
+
+// LoginRecorder records a successful login, as authentication.Logins does.
+type LoginRecorder interface {
+	LoggedIn(ctx context.Context, userID uint64, method string)
+}
+
+type Handler struct {
+	cfg      config.Oidc
+	provider *Provider
+	repo     Repo
+	session  *scs.SessionManager
+	logins   LoginRecorder
+}
+
+type Option func(h *Handler)
+
+// WithLoginRecorder records logins into login_events, and alerts users to
+// logins from new devices. Logins are not recorded without it.
+func WithLoginRecorder(logins LoginRecorder) Option {
+	return func(h *Handler) {
+		h.logins = logins
+	}
+}
+
+func NewHandler(cfg config.Oidc, provider *Provider, repo Repo, session *scs.SessionManager, opts ...Option) *Handler {
+	h := &Handler{
+		cfg:      cfg,
+		provider: provider,
+		repo:     repo,
+		session:  session,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Login starts an authorization code flow with PKCE by redirecting the browser
+// to the identity provider. State, nonce and code verifier are kept in the
+// session to be checked in Callback.
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	state, err := randomString()
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	nonce, err := randomString()
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	url, err := h.provider.AuthCodeURL(state, nonce, verifier)
+	if err != nil {
+		slog.ErrorContext(ctx, "oidc discovery", "error", err)
+		respond.Status(w, http.StatusBadGateway)
+		return
+	}
+
+	h.session.Put(ctx, keyState, state)
+	h.session.Put(ctx, keyNonce, nonce)
+	h.session.Put(ctx, keyVerifier, verifier)
+
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// Callback completes the authorization code flow. On success, a normal session
+// is created just like a password login.
+func (h *Handler) Callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	state := h.session.PopString(ctx, keyState)
+	nonce := h.session.PopString(ctx, keyNonce)
+	verifier := h.session.PopString(ctx, keyVerifier)
+
+	if query.Get("error") != "" {
+		respond.Error(w, http.StatusUnauthorized, errors.New(query.Get("error")))
+		return
+	}
+
+	if state == "" || query.Get("state") != state {
+		respond.Error(w, http.StatusBadRequest, ErrInvalidState)
+		return
+	}
+
+	claims, err := h.provider.Exchange(ctx, query.Get("code"), verifier)
+	if err != nil {
+		slog.ErrorContext(ctx, "oidc exchange", "error", err)
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	if claims.Nonce != nonce {
+		respond.Error(w, http.StatusUnauthorized, ErrInvalidNonce)
+		return
+	}
+
+	userID, err := h.repo.Link(ctx, claims, h.cfg.AllowSignup)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrSignupNotAllowed), errors.Is(err, ErrAccountDisabled), errors.Is(err, ErrVerifiedEmailRequired):
+			respond.Error(w, http.StatusForbidden, err)
+		case errors.Is(err, ErrEmailNotAvailable):
+			respond.Error(w, http.StatusConflict, err)
+		default:
+			respond.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if err := h.session.RenewToken(ctx); err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	h.session.Put(ctx, string(middleware.KeyID), userID)
+	h.session.Put(ctx, string(middleware.KeyAuthTime), time.Now().Unix())
+	if h.logins != nil {
+		h.logins.LoggedIn(ctx, userID, authentication.MethodOIDC)
+	}
+
+	if h.cfg.PostLoginRedirect != "" {
+		http.Redirect(w, r, h.cfg.PostLoginRedirect, http.StatusSeeOther)
+		return
+	}
+
+	respond.Status(w, http.StatusOK)
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
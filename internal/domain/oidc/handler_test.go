@@ -0,0 +1,331 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/config"
+	"github.com/gmhafiz/go8/internal/domain/authentication"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+const (
+	clientID    = "go8"
+	sessionName = "session"
+)
+
+// mockIdP is a minimal OpenID Connect provider supporting discovery, JWKS and
+// the token endpoint.
+type mockIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu     sync.Mutex
+	claims map[string]any // claims issued for next authorization code
+	nonce  string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	idp := &mockIdP{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                idp.server.URL,
+			"authorization_endpoint":                idp.server.URL + "/authorize",
+			"token_endpoint":                        idp.server.URL + "/token",
+			"jwks_uri":                              idp.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &key.PublicKey,
+			KeyID:     "test",
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		idp.mu.Lock()
+		claims := idp.claims
+		claims["nonce"] = idp.nonce
+		idp.mu.Unlock()
+
+		signer, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.RS256, Key: key},
+			(&jose.SignerOptions{}).WithHeader("kid", "test"),
+		)
+		assert.Nil(t, err)
+
+		raw, err := jwt.Signed(signer).Claims(claims).Serialize()
+		assert.Nil(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     raw,
+		})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+type fakeRepo struct {
+	linked map[string]uint64
+}
+
+func (f *fakeRepo) Link(_ context.Context, claims *Claims, allowSignup bool) (uint64, error) {
+	if userID, ok := f.linked[claims.Subject]; ok {
+		return userID, nil
+	}
+	if !allowSignup {
+		return 0, ErrSignupNotAllowed
+	}
+	if !claims.EmailVerified {
+		return 0, ErrVerifiedEmailRequired
+	}
+	if claims.Subject == "taken" {
+		return 0, ErrEmailNotAvailable
+	}
+	userID := uint64(len(f.linked) + 1)
+	f.linked[claims.Subject] = userID
+	return userID, nil
+}
+
+func TestHandler_Callback(t *testing.T) {
+	type args struct {
+		subject     string
+		audience    string
+		allowSignup bool
+		badNonce    bool
+		unverified  bool
+	}
+	type want struct {
+		status int
+		userID uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "linked user",
+			args: args{subject: "existing", audience: clientID},
+			want: want{status: http.StatusOK, userID: 7},
+		},
+		{
+			name: "just in time provisioning",
+			args: args{subject: "new", audience: clientID, allowSignup: true},
+			want: want{status: http.StatusOK, userID: 2},
+		},
+		{
+			name: "signup not allowed",
+			args: args{subject: "new", audience: clientID},
+			want: want{status: http.StatusForbidden},
+		},
+		{
+			name: "signup with an unverified email",
+			args: args{subject: "new", audience: clientID, allowSignup: true, unverified: true},
+			want: want{status: http.StatusForbidden},
+		},
+		{
+			name: "signup with an email already in use",
+			args: args{subject: "taken", audience: clientID, allowSignup: true},
+			want: want{status: http.StatusConflict},
+		},
+		{
+			name: "wrong audience",
+			args: args{subject: "existing", audience: "someone-else"},
+			want: want{status: http.StatusUnauthorized},
+		},
+		{
+			name: "nonce mismatch",
+			args: args{subject: "existing", audience: clientID, badNonce: true},
+			want: want{status: http.StatusUnauthorized},
+		},
+	}
+
+	idp := newMockIdP(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Oidc{
+				Issuer:      idp.server.URL,
+				ClientID:    clientID,
+				RedirectURL: "http://localhost/api/v1/oidc/callback",
+				Scopes:      []string{"openid", "email"},
+				AllowSignup: tt.args.allowSignup,
+			}
+			session := newSession()
+			repo := &fakeRepo{linked: map[string]uint64{"existing": 7}}
+			logins := &fakeLogins{}
+
+			router := chi.NewRouter()
+			router.Use(middleware.LoadAndSave(session))
+			RegisterHTTPEndPoints(router, cfg, session, repo, WithLoginRecorder(logins))
+			router.With(middleware.Authenticate(session)).Get("/me", func(w http.ResponseWriter, r *http.Request) {
+				userID, _ := middleware.UserID(r.Context())
+				_ = json.NewEncoder(w).Encode(userID)
+			})
+
+			// Login redirects to identity provider with PKCE challenge.
+			ww := httptest.NewRecorder()
+			router.ServeHTTP(ww, httptest.NewRequest(http.MethodGet, "/api/v1/oidc/login", nil))
+
+			assert.Equal(t, http.StatusFound, ww.Code)
+			location, err := url.Parse(ww.Header().Get("Location"))
+			assert.Nil(t, err)
+			assert.True(t, strings.HasPrefix(location.String(), idp.server.URL+"/authorize"))
+			assert.Equal(t, "S256", location.Query().Get("code_challenge_method"))
+			assert.NotEmpty(t, location.Query().Get("code_challenge"))
+
+			cookie := sessionCookie(ww)
+			assert.NotNil(t, cookie)
+
+			idp.mu.Lock()
+			idp.nonce = location.Query().Get("nonce")
+			if tt.args.badNonce {
+				idp.nonce = "tampered"
+			}
+			idp.claims = map[string]any{
+				"iss":            idp.server.URL,
+				"sub":            tt.args.subject,
+				"aud":            tt.args.audience,
+				"exp":            time.Now().Add(time.Minute).Unix(),
+				"iat":            time.Now().Unix(),
+				"email":          tt.args.subject + "@example.com",
+				"email_verified": !tt.args.unverified,
+			}
+			idp.mu.Unlock()
+
+			// Identity provider redirects the browser back with the code.
+			callback := "/api/v1/oidc/callback?code=abc&state=" + location.Query().Get("state")
+			rr := httptest.NewRequest(http.MethodGet, callback, nil)
+			rr.AddCookie(cookie)
+			ww = httptest.NewRecorder()
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, tt.want.status, ww.Code)
+			if tt.want.status != http.StatusOK {
+				assert.Empty(t, logins.userIDs)
+				return
+			}
+
+			// The login is recorded like any other.
+			assert.Equal(t, []uint64{tt.want.userID}, logins.userIDs)
+			assert.Equal(t, []string{authentication.MethodOIDC}, logins.methods)
+
+			// A normal session is created.
+			renewed := sessionCookie(ww)
+			assert.NotNil(t, renewed)
+			assert.NotEqual(t, cookie.Value, renewed.Value)
+
+			rr = httptest.NewRequest(http.MethodGet, "/me", nil)
+			rr.AddCookie(renewed)
+			ww = httptest.NewRecorder()
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, http.StatusOK, ww.Code)
+			var userID uint64
+			err = json.NewDecoder(ww.Body).Decode(&userID)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want.userID, userID)
+		})
+	}
+}
+
+func TestHandler_Callback_Invalid_State(t *testing.T) {
+	idp := newMockIdP(t)
+
+	cfg := config.Oidc{Issuer: idp.server.URL, ClientID: clientID}
+	session := newSession()
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	RegisterHTTPEndPoints(router, cfg, session, &fakeRepo{})
+
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, httptest.NewRequest(http.MethodGet, "/api/v1/oidc/callback?code=abc&state=forged", nil))
+
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+}
+
+func sessionCookie(ww *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range ww.Result().Cookies() {
+		if cookie.Name == sessionName {
+			return cookie
+		}
+	}
+	return nil
+}
+
+// memCtxStore adapts memstore to scs.CtxStore so that Authenticate middleware
+// can be used without a database.
+type memCtxStore struct {
+	*memstore.MemStore
+}
+
+func (m memCtxStore) DeleteCtx(_ context.Context, token string) error {
+	return m.Delete(token)
+}
+
+func (m memCtxStore) FindCtx(_ context.Context, token string) ([]byte, bool, error) {
+	return m.Find(token)
+}
+
+func (m memCtxStore) CommitCtx(_ context.Context, token string, b []byte, expiry time.Time) error {
+	return m.Commit(token, b, expiry)
+}
+
+func newSession() *scs.SessionManager {
+	store := memCtxStore{memstore.New()}
+
+	manager := scs.New()
+	manager.Store = store
+	manager.CtxStore = store
+	manager.Lifetime = time.Hour
+	manager.Cookie.Name = sessionName
+
+	return manager
+}
+
+type fakeLogins struct {
+	userIDs []uint64
+	methods []string
+}
+
+func (f *fakeLogins) LoggedIn(_ context.Context, userID uint64, method string) {
+	f.userIDs = append(f.userIDs, userID)
+	f.methods = append(f.methods, method)
+}
@@ -0,0 +1,30 @@
+package oidc
+
+import (
+	"net/http"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+func init() {
+	respond.RegisterProblem(ErrSignupNotAllowed, respond.ProblemType{
+		Type:   "/problems/signup-not-allowed",
+		Title:  "Signup not allowed",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrAccountDisabled, respond.ProblemType{
+		Type:   "/problems/account-disabled",
+		Title:  "Account disabled",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrVerifiedEmailRequired, respond.ProblemType{
+		Type:   "/problems/verified-email-required",
+		Title:  "Verified email required",
+		Status: http.StatusForbidden,
+	})
+	respond.RegisterProblem(ErrEmailNotAvailable, respond.ProblemType{
+		Type:   "/problems/email-conflict",
+		Title:  "Email conflict",
+		Status: http.StatusConflict,
+	})
+}
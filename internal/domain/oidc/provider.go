@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+// Provider lazily discovers the identity provider's endpoints. Discovery is
+// retried on next login if the identity provider is unreachable, so the API
+// can still start without it. The JWKS used to validate ID tokens is fetched
+// and cached by go-oidc's remote key set, and is refreshed when an unknown key
+// ID is seen.
+type Provider struct {
+	cfg    config.Oidc
+	client *http.Client
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewProvider(cfg config.Oidc) *Provider {
+	return &Provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *Provider) load() (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth2 != nil {
+		return p.oauth2, p.verifier, nil
+	}
+
+	// Remote key set keeps using this context to fetch keys, so it must not
+	// be a request-scoped context.
+	ctx := oidc.ClientContext(context.Background(), p.client)
+
+	provider, err := oidc.NewProvider(ctx, p.cfg.Issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+	}
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID})
+
+	return p.oauth2, p.verifier, nil
+}
+
+// AuthCodeURL returns the identity provider's URL to redirect the browser to.
+func (p *Provider) AuthCodeURL(state, nonce, verifier string) (string, error) {
+	cfg, _, err := p.load()
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange trades authorization code for tokens and returns the validated
+// ID token claims.
+func (p *Provider) Exchange(ctx context.Context, code, verifier string) (*Claims, error) {
+	cfg, idTokenVerifier, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = oidc.ClientContext(ctx, p.client)
+
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, ErrNoIDToken
+	}
+
+	idToken, err := idTokenVerifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,19 @@
+package oidc
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, cfg config.Oidc, session *scs.SessionManager, repo Repo, opts ...Option) *Handler {
+	h := NewHandler(cfg, NewProvider(cfg), repo, session, opts...)
+
+	router.Route("/api/v1/oidc", func(router chi.Router) {
+		router.Get("/login", h.Login)
+		router.Get("/callback", h.Callback)
+	})
+
+	return h
+}
@@ -0,0 +1,136 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/ent/gen/useridentity"
+)
+
+var (
+	ErrSignupNotAllowed      = errors.New("no account is linked to this identity")
+	ErrAccountDisabled       = errors.New("account is disabled")
+	ErrVerifiedEmailRequired = errors.New("a verified email is required to sign up")
+	ErrEmailNotAvailable     = errors.New("email is already used by another account")
+)
+
+type Repo interface {
+	// Link returns the local user ID linked to the external subject. An
+	// identity is linked to an existing user with the same verified email, or
+	// to a newly provisioned user if allowSignup is true and the email is
+	// verified. ErrAccountDisabled is returned when the linked user is
+	// disabled.
+	Link(ctx context.Context, claims *Claims, allowSignup bool) (uint64, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) Link(ctx context.Context, claims *Claims, allowSignup bool) (uint64, error) {
+	userID, err := r.link(ctx, claims, allowSignup)
+	if err != nil {
+		return 0, err
+	}
+
+	disabled, err := r.ent.User.Query().
+		Where(user.ID(userID), user.DisabledAtNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if disabled {
+		return 0, ErrAccountDisabled
+	}
+
+	return userID, nil
+}
+
+func (r *repo) link(ctx context.Context, claims *Claims, allowSignup bool) (uint64, error) {
+	identity, err := r.ent.UserIdentity.Query().
+		Where(
+			useridentity.IssuerEQ(claims.Issuer),
+			useridentity.SubjectEQ(claims.Subject),
+		).
+		Only(ctx)
+	if err == nil {
+		return identity.UserID, nil
+	}
+	if !gen.IsNotFound(err) {
+		return 0, err
+	}
+
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, err := linkUser(ctx, tx, claims, allowSignup)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	err = tx.UserIdentity.Create().
+		SetUserID(userID).
+		SetIssuer(claims.Issuer).
+		SetSubject(claims.Subject).
+		SetEmail(claims.Email).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	return userID, tx.Commit()
+}
+
+func linkUser(ctx context.Context, tx *gen.Tx, claims *Claims, allowSignup bool) (uint64, error) {
+	// Only a verified email can be trusted to belong to the same person.
+	if claims.Email != "" && claims.EmailVerified {
+		u, err := tx.User.Query().Where(user.EmailEqualFold(claims.Email)).Only(ctx)
+		if err == nil {
+			return u.ID, nil
+		}
+		if !gen.IsNotFound(err) {
+			return 0, err
+		}
+	}
+
+	if !allowSignup {
+		return 0, ErrSignupNotAllowed
+	}
+	// Every user needs an email of their own. An unverified one may belong to
+	// someone else, including a local account.
+	if claims.Email == "" || !claims.EmailVerified {
+		return 0, ErrVerifiedEmailRequired
+	}
+
+	u, err := tx.User.Create().
+		SetFirstName(claims.GivenName).
+		SetLastName(claims.FamilyName).
+		SetEmail(claims.Email).
+		// User provisioned this way can only log in through the identity
+		// provider because an empty hash never matches a password.
+		SetPassword("").
+		SetVerifiedAt(time.Now()).
+		Save(ctx)
+	if gen.IsConstraintError(err) {
+		// Someone else signed up with the same email in the meantime.
+		return 0, ErrEmailNotAvailable
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return u.ID, nil
+}
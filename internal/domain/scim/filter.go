@@ -0,0 +1,289 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Attributes that can be filtered on, in lower case since SCIM attribute names
+// are case-insensitive.
+const (
+	attrID         = "id"
+	attrExternalID = "externalid"
+	attrUserName   = "username"
+	attrGivenName  = "name.givenname"
+	attrMiddleName = "name.middlename"
+	attrFamilyName = "name.familyname"
+	attrEmails     = "emails.value"
+	attrActive     = "active"
+)
+
+var filterAttributes = []string{
+	attrID, attrExternalID, attrUserName, attrGivenName, attrMiddleName, attrFamilyName, attrEmails, attrActive,
+}
+
+// Filter is a parsed SCIM filter expression as described in RFC 7644 section
+// 3.4.2.2. Op is either a logical operator (and, or, not), with operands in Left
+// and Right, or an attribute operator (eq, ne, co, sw, ew, gt, ge, lt, le, pr)
+// comparing Attr against Value.
+type Filter struct {
+	Op    string
+	Left  *Filter
+	Right *Filter
+
+	Attr string
+	// Value is a string, bool, float64 or nil.
+	Value any
+}
+
+// ParseFilter parses a filter such as `userName eq "jane@example.com"`.
+// Complex attribute filters such as `emails[type eq "work"]` are not supported.
+func ParseFilter(s string) (*Filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, p.tokens[p.pos].text)
+	}
+
+	return f, nil
+}
+
+type token struct {
+	text string
+	// quoted is true for string literals, whose text is already unquoted.
+	quoted bool
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, token{text: string(c)})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(s) && s[end] != '"'; end++ {
+				if s[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+			}
+			var text string
+			if err := json.Unmarshal([]byte(s[i:end+1]), &text); err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, err)
+			}
+			tokens = append(tokens, token{text: text, quoted: true})
+			i = end + 1
+		case c == '[':
+			return nil, fmt.Errorf("%w: complex attribute filters are not supported", ErrInvalidFilter)
+		default:
+			end := i
+			for end < len(s) && !strings.ContainsRune(" \t()\"[", rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, token{text: s[i:end]})
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, error) {
+	t, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("%w: unexpected end of filter", ErrInvalidFilter)
+	}
+	p.pos++
+	return t, nil
+}
+
+// keyword reports whether next token is the given unquoted keyword, and if so
+// consumes it.
+func (p *parser) keyword(word string) bool {
+	t, ok := p.peek()
+	if ok && !t.quoted && strings.EqualFold(t.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.quoted || t.text != text {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidFilter, text, t.text)
+	}
+	return nil
+}
+
+// or has the lowest precedence, followed by and.
+func (p *parser) or() (*Filter, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = &Filter{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (*Filter, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = &Filter{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) factor() (*Filter, error) {
+	if p.keyword("not") {
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &Filter{Op: "not", Left: inner}, nil
+	}
+
+	if t, ok := p.peek(); ok && !t.quoted && t.text == "(" {
+		p.pos++
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	}
+
+	return p.comparison()
+}
+
+func (p *parser) comparison() (*Filter, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	attr, err := attribute(t.text)
+	if t.quoted || err != nil {
+		return nil, fmt.Errorf("%w: unknown attribute %q", ErrInvalidFilter, t.text)
+	}
+
+	t, err = p.next()
+	if err != nil {
+		return nil, err
+	}
+	op := strings.ToLower(t.text)
+	switch op {
+	case "pr":
+		return &Filter{Op: op, Attr: attr}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidFilter, t.text)
+	}
+
+	t, err = p.next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := literal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Filter{Op: op, Attr: attr, Value: value}, nil
+}
+
+// attribute normalises an attribute path, which may be prefixed by the User
+// schema URN. `emails` on its own means `emails.value`.
+func attribute(path string) (string, error) {
+	path = strings.ToLower(path)
+	path = strings.TrimPrefix(path, strings.ToLower(SchemaUser)+":")
+	if path == "emails" {
+		path = attrEmails
+	}
+
+	for _, attr := range filterAttributes {
+		if path == attr {
+			return attr, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown attribute %q", ErrInvalidFilter, path)
+}
+
+func literal(t token) (any, error) {
+	if t.quoted {
+		return t.text, nil
+	}
+
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if len(t.text) > 0 && (unicode.IsDigit(rune(t.text[0])) || t.text[0] == '-') {
+		if n, err := strconv.ParseFloat(t.text, 64); err == nil {
+			return n, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: invalid value %q", ErrInvalidFilter, t.text)
+}
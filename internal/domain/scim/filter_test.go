@@ -0,0 +1,88 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   *Filter
+		err    error
+	}{
+		{
+			name:   "equal",
+			filter: `userName eq "jane@example.com"`,
+			want:   &Filter{Op: "eq", Attr: attrUserName, Value: "jane@example.com"},
+		},
+		{
+			name:   "attribute and operator are case-insensitive",
+			filter: `USERNAME Eq "jane@example.com"`,
+			want:   &Filter{Op: "eq", Attr: attrUserName, Value: "jane@example.com"},
+		},
+		{
+			name:   "schema prefix",
+			filter: `urn:ietf:params:scim:schemas:core:2.0:User:name.familyName sw "O'"`,
+			want:   &Filter{Op: "sw", Attr: attrFamilyName, Value: "O'"},
+		},
+		{
+			name:   "escaped quote",
+			filter: `externalId eq "a\"b"`,
+			want:   &Filter{Op: "eq", Attr: attrExternalID, Value: `a"b`},
+		},
+		{
+			name:   "present",
+			filter: `externalId pr`,
+			want:   &Filter{Op: "pr", Attr: attrExternalID},
+		},
+		{
+			name:   "emails means emails.value",
+			filter: `emails co "@example.com"`,
+			want:   &Filter{Op: "co", Attr: attrEmails, Value: "@example.com"},
+		},
+		{
+			name:   "and binds tighter than or",
+			filter: `active eq true or userName eq "a" and externalId eq "b"`,
+			want: &Filter{
+				Op:   "or",
+				Left: &Filter{Op: "eq", Attr: attrActive, Value: true},
+				Right: &Filter{
+					Op:    "and",
+					Left:  &Filter{Op: "eq", Attr: attrUserName, Value: "a"},
+					Right: &Filter{Op: "eq", Attr: attrExternalID, Value: "b"},
+				},
+			},
+		},
+		{
+			name:   "parentheses and not",
+			filter: `not (active eq false) and (userName eq "a" or userName eq "b")`,
+			want: &Filter{
+				Op:   "and",
+				Left: &Filter{Op: "not", Left: &Filter{Op: "eq", Attr: attrActive, Value: false}},
+				Right: &Filter{
+					Op:    "or",
+					Left:  &Filter{Op: "eq", Attr: attrUserName, Value: "a"},
+					Right: &Filter{Op: "eq", Attr: attrUserName, Value: "b"},
+				},
+			},
+		},
+		{name: "unknown attribute", filter: `title eq "a"`, err: ErrInvalidFilter},
+		{name: "unknown operator", filter: `userName like "a"`, err: ErrInvalidFilter},
+		{name: "missing value", filter: `userName eq`, err: ErrInvalidFilter},
+		{name: "unterminated string", filter: `userName eq "a`, err: ErrInvalidFilter},
+		{name: "unbalanced parenthesis", filter: `(userName eq "a"`, err: ErrInvalidFilter},
+		{name: "trailing token", filter: `userName eq "a" "b"`, err: ErrInvalidFilter},
+		{name: "complex attribute filter", filter: `emails[type eq "work"]`, err: ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFilter(tt.filter)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
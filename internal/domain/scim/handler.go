@@ -0,0 +1,289 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gmhafiz/go8/internal/utility/param"
+)
+
+// maxResults caps the count of a list request.
+const maxResults = 100
+
+var (
+	ErrUserNameRequired = errors.New("userName is required")
+	ErrUnauthorized     = errors.New("missing or invalid bearer token")
+)
+
+type Handler struct {
+	repo Repo
+	// baseURL is the public URL of the SCIM endpoint, used in meta.location.
+	baseURL string
+}
+
+func NewHandler(repo Repo, baseURL string) *Handler {
+	return &Handler{
+		repo:    repo,
+		baseURL: baseURL,
+	}
+}
+
+// Authenticate only lets through requests bearing one of tokens. Unlike the
+// rest of the API, a SCIM client is a machine without a user or session.
+func Authenticate(tokens []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || bearer == "" {
+				respondError(w, r, http.StatusUnauthorized, "", ErrUnauthorized)
+				return
+			}
+
+			// Compares every token so that timing does not reveal which one
+			// is close.
+			var match int
+			for _, token := range tokens {
+				if token != "" {
+					match |= subtle.ConstantTimeCompare([]byte(bearer), []byte(token))
+				}
+			}
+			if match != 1 {
+				respondError(w, r, http.StatusUnauthorized, "", ErrUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (h *Handler) ServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	respondJSON(w, r, http.StatusOK, ServiceProviderConfig(maxResults))
+}
+
+// List users
+// @Summary List users
+// @Description Lists users in SCIM format, optionally filtered.
+// @Produce application/scim+json
+// @Param filter query string false "SCIM filter, e.g. userName eq \"jane@example.com\""
+// @Param startIndex query int false "1-based index of the first result"
+// @Param count query int false "maximum number of results"
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse
+// @router /scim/v2/Users [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var f *Filter
+	if s := query.Get("filter"); s != "" {
+		var err error
+		if f, err = ParseFilter(s); err != nil {
+			respondError(w, r, http.StatusBadRequest, "invalidFilter", err)
+			return
+		}
+	}
+
+	startIndex, _ := strconv.Atoi(query.Get("startIndex"))
+	startIndex = max(startIndex, 1)
+
+	count := maxResults
+	if s := query.Get("count"); s != "" {
+		count, _ = strconv.Atoi(s)
+		count = min(max(count, 0), maxResults)
+	}
+
+	users, total, err := h.repo.List(r.Context(), f, startIndex-1, count)
+	if errors.Is(err, ErrInvalidFilter) {
+		respondError(w, r, http.StatusBadRequest, "invalidFilter", err)
+		return
+	} else if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	respondJSON(w, r, http.StatusOK, ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(users),
+		Resources:    UserResources(users, h.baseURL),
+	})
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respondError(w, r, http.StatusNotFound, "", ErrUserNotFound)
+		return
+	}
+
+	u, err := h.repo.Read(r.Context(), userID)
+	if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	respondJSON(w, r, http.StatusOK, UserResource(u, h.baseURL))
+}
+
+// Create provisions a user. The user has no password until they set one.
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req UserRequest
+	if err := decode(w, r, &req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "invalidSyntax", err)
+		return
+	}
+
+	u := req.user()
+	if u.UserName == "" {
+		respondError(w, r, http.StatusBadRequest, "invalidValue", ErrUserNameRequired)
+		return
+	}
+
+	created, err := h.repo.Create(r.Context(), u)
+	if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	resource := UserResource(created, h.baseURL)
+	w.Header().Set("Location", resource.Meta.Location)
+	respondJSON(w, r, http.StatusCreated, resource)
+}
+
+// Replace overwrites a user. Omitted attributes are cleared, and an omitted
+// active means active.
+func (h *Handler) Replace(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respondError(w, r, http.StatusNotFound, "", ErrUserNotFound)
+		return
+	}
+
+	var req UserRequest
+	if err := decode(w, r, &req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "invalidSyntax", err)
+		return
+	}
+
+	u := req.user()
+	u.ID = userID
+	if u.UserName == "" {
+		respondError(w, r, http.StatusBadRequest, "invalidValue", ErrUserNameRequired)
+		return
+	}
+
+	updated, err := h.repo.Replace(r.Context(), u)
+	if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	respondJSON(w, r, http.StatusOK, UserResource(updated, h.baseURL))
+}
+
+// Patch modifies some attributes of a user. Identity providers mostly use it
+// to deactivate a user with `replace active false`.
+func (h *Handler) Patch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respondError(w, r, http.StatusNotFound, "", ErrUserNotFound)
+		return
+	}
+
+	var req PatchRequest
+	if err := decode(w, r, &req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "invalidSyntax", err)
+		return
+	}
+
+	u, err := h.repo.Read(ctx, userID)
+	if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	if err := applyPatch(u, req.Operations); err != nil {
+		respondError(w, r, http.StatusBadRequest, "invalidValue", err)
+		return
+	}
+
+	updated, err := h.repo.Replace(ctx, u)
+	if err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	respondJSON(w, r, http.StatusOK, UserResource(updated, h.baseURL))
+}
+
+// Delete removes a user along with their sessions.
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respondError(w, r, http.StatusNotFound, "", ErrUserNotFound)
+		return
+	}
+
+	if err := h.repo.Delete(r.Context(), userID); err != nil {
+		respondRepoError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode is more lenient than request.Decode. SCIM clients routinely send
+// attributes this service does not store, which must be ignored.
+func decode(w http.ResponseWriter, r *http.Request, dst any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, 1_048_576)
+
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return errors.New("body contains badly-formed JSON")
+	}
+	return nil
+}
+
+func respondJSON(w http.ResponseWriter, r *http.Request, statusCode int, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "scim: encoding response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		slog.ErrorContext(r.Context(), "scim: writing response", "error", err)
+	}
+}
+
+// respondError writes an error in the format of RFC 7644 section 3.12.
+func respondError(w http.ResponseWriter, r *http.Request, statusCode int, scimType string, err error) {
+	respondJSON(w, r, statusCode, ErrorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(statusCode),
+		ScimType: scimType,
+		Detail:   err.Error(),
+	})
+}
+
+func respondRepoError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		respondError(w, r, http.StatusNotFound, "", err)
+	case errors.Is(err, ErrUserNameTaken), errors.Is(err, ErrExternalIDTaken):
+		respondError(w, r, http.StatusConflict, "uniqueness", err)
+	default:
+		slog.ErrorContext(r.Context(), "scim: unexpected error", "error", err)
+		respondError(w, r, http.StatusInternalServerError, "", errors.New(http.StatusText(http.StatusInternalServerError)))
+	}
+}
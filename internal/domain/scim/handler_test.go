@@ -0,0 +1,257 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+const bearerToken = "secret"
+
+type fakeRepo struct {
+	users  map[uint64]*User
+	nextID uint64
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		users: map[uint64]*User{
+			1: {ID: 1, UserName: "jane@example.com", ExternalID: "jane", GivenName: "Jane", Active: true},
+		},
+		nextID: 2,
+	}
+}
+
+func (f *fakeRepo) List(_ context.Context, _ *Filter, offset, limit int) ([]*User, int, error) {
+	var users []*User
+	for id := uint64(1); id < f.nextID; id++ {
+		if u, ok := f.users[id]; ok {
+			users = append(users, u)
+		}
+	}
+	total := len(users)
+	users = users[min(offset, total):min(offset+limit, total)]
+	return users, total, nil
+}
+
+func (f *fakeRepo) Read(_ context.Context, userID uint64) (*User, error) {
+	u, ok := f.users[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (f *fakeRepo) Create(_ context.Context, u *User) (*User, error) {
+	for _, existing := range f.users {
+		if strings.EqualFold(existing.UserName, u.UserName) {
+			return nil, ErrUserNameTaken
+		}
+	}
+	u.ID = f.nextID
+	f.nextID++
+	f.users[u.ID] = u
+	return u, nil
+}
+
+func (f *fakeRepo) Replace(_ context.Context, u *User) (*User, error) {
+	if _, ok := f.users[u.ID]; !ok {
+		return nil, ErrUserNotFound
+	}
+	f.users[u.ID] = u
+	return u, nil
+}
+
+func (f *fakeRepo) Delete(_ context.Context, userID uint64) error {
+	if _, ok := f.users[userID]; !ok {
+		return ErrUserNotFound
+	}
+	delete(f.users, userID)
+	return nil
+}
+
+func newRouter(repo Repo) *chi.Mux {
+	router := chi.NewRouter()
+	RegisterHTTPEndPoints(router, config.Scim{Enable: true, Tokens: []string{"old", bearerToken}}, "https://example.com", repo)
+	return router
+}
+
+func do(router *chi.Mux, method, target, body string) *httptest.ResponseRecorder {
+	rr := httptest.NewRequest(method, target, strings.NewReader(body))
+	rr.Header.Set("Authorization", "Bearer "+bearerToken)
+	rr.Header.Set("Content-Type", ContentType)
+	ww := httptest.NewRecorder()
+	router.ServeHTTP(ww, rr)
+	return ww
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+	}{
+		{name: "current bearerToken", authorization: "Bearer " + bearerToken, status: http.StatusOK},
+		{name: "rotated bearerToken", authorization: "Bearer old", status: http.StatusOK},
+		{name: "wrong bearerToken", authorization: "Bearer wrong", status: http.StatusUnauthorized},
+		{name: "not a bearer bearerToken", authorization: "Basic " + bearerToken, status: http.StatusUnauthorized},
+		{name: "missing", status: http.StatusUnauthorized},
+	}
+
+	router := newRouter(newFakeRepo())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+			if tt.authorization != "" {
+				rr.Header.Set("Authorization", tt.authorization)
+			}
+			ww := httptest.NewRecorder()
+			router.ServeHTTP(ww, rr)
+
+			assert.Equal(t, tt.status, ww.Code)
+			assert.Equal(t, ContentType, ww.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestHandler_Create(t *testing.T) {
+	router := newRouter(newFakeRepo())
+
+	// Unknown attributes such as title are ignored.
+	ww := do(router, http.MethodPost, "/scim/v2/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"externalId": "john",
+		"userName": "john@example.com",
+		"name": {"givenName": "John", "familyName": "Doe"},
+		"title": "Engineer",
+		"active": false
+	}`)
+	assert.Equal(t, http.StatusCreated, ww.Code)
+	assert.Equal(t, "https://example.com/scim/v2/Users/2", ww.Header().Get("Location"))
+
+	var got UserResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Equal(t, "2", got.ID)
+	assert.Equal(t, "john", got.ExternalID)
+	assert.Equal(t, "Doe", got.Name.FamilyName)
+	assert.Equal(t, []Email{{Value: "john@example.com", Primary: true}}, got.Emails)
+	assert.False(t, got.Active)
+
+	ww = do(router, http.MethodPost, "/scim/v2/Users", `{"userName": "JOHN@example.com"}`)
+	assert.Equal(t, http.StatusConflict, ww.Code)
+
+	var scimErr ErrorResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&scimErr))
+	assert.Equal(t, "409", scimErr.Status)
+	assert.Equal(t, "uniqueness", scimErr.ScimType)
+
+	// userName falls back to the primary email.
+	ww = do(router, http.MethodPost, "/scim/v2/Users",
+		`{"emails": [{"value": "work@example.com"}, {"value": "home@example.com", "primary": true}]}`)
+	assert.Equal(t, http.StatusCreated, ww.Code)
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Equal(t, "home@example.com", got.UserName)
+	assert.True(t, got.Active)
+
+	ww = do(router, http.MethodPost, "/scim/v2/Users", `{"name": {"givenName": "Nobody"}}`)
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+}
+
+func TestHandler_Patch(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		want   User
+	}{
+		{
+			name:   "deactivate",
+			body:   `{"Operations": [{"op": "replace", "path": "active", "value": false}]}`,
+			status: http.StatusOK,
+			want:   User{ID: 1, UserName: "jane@example.com", ExternalID: "jane", GivenName: "Jane"},
+		},
+		{
+			name:   "without path and with a string boolean",
+			body:   `{"Operations": [{"op": "Replace", "value": {"active": "False", "name.familyName": "Doe"}}]}`,
+			status: http.StatusOK,
+			want:   User{ID: 1, UserName: "jane@example.com", ExternalID: "jane", GivenName: "Jane", FamilyName: "Doe"},
+		},
+		{
+			name: "several operations",
+			body: `{"Operations": [
+				{"op": "add", "path": "name", "value": {"middleName": "M"}},
+				{"op": "remove", "path": "externalId"},
+				{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "ignored@example.com"}
+			]}`,
+			status: http.StatusOK,
+			want:   User{ID: 1, UserName: "jane@example.com", GivenName: "Jane", MiddleName: "M", Active: true},
+		},
+		{
+			name:   "remove userName",
+			body:   `{"Operations": [{"op": "remove", "path": "userName"}]}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "unknown op",
+			body:   `{"Operations": [{"op": "move", "path": "active"}]}`,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			router := newRouter(repo)
+
+			ww := do(router, http.MethodPatch, "/scim/v2/Users/1", tt.body)
+			assert.Equal(t, tt.status, ww.Code)
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.want, *repo.users[1])
+			}
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	router := newRouter(newFakeRepo())
+	do(router, http.MethodPost, "/scim/v2/Users", `{"userName": "john@example.com"}`)
+
+	ww := do(router, http.MethodGet, "/scim/v2/Users?startIndex=2&count=10", "")
+	assert.Equal(t, http.StatusOK, ww.Code)
+
+	var got ListResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&got))
+	assert.Equal(t, 2, got.TotalResults)
+	assert.Equal(t, 2, got.StartIndex)
+	assert.Equal(t, 1, got.ItemsPerPage)
+	assert.Equal(t, "john@example.com", got.Resources[0].UserName)
+
+	ww = do(router, http.MethodGet, `/scim/v2/Users?filter=title+eq+"a"`, "")
+	assert.Equal(t, http.StatusBadRequest, ww.Code)
+
+	var scimErr ErrorResponse
+	assert.Nil(t, json.NewDecoder(ww.Body).Decode(&scimErr))
+	assert.Equal(t, "invalidFilter", scimErr.ScimType)
+}
+
+func TestHandler_Delete(t *testing.T) {
+	router := newRouter(newFakeRepo())
+
+	ww := do(router, http.MethodDelete, "/scim/v2/Users/1", "")
+	assert.Equal(t, http.StatusNoContent, ww.Code)
+
+	ww = do(router, http.MethodGet, "/scim/v2/Users/1", "")
+	assert.Equal(t, http.StatusNotFound, ww.Code)
+
+	ww = do(router, http.MethodGet, "/scim/v2/Users/not-a-number", "")
+	assert.Equal(t, http.StatusNotFound, ww.Code)
+}
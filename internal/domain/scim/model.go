@@ -0,0 +1,24 @@
+package scim
+
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// ContentType is the media type of every SCIM request and response.
+	ContentType = "application/scim+json"
+)
+
+// User is the part of a `users` row managed through SCIM. UserName is stored
+// as the email address.
+type User struct {
+	ID         uint64
+	ExternalID string
+	UserName   string
+	GivenName  string
+	MiddleName string
+	FamilyName string
+	Active     bool
+}
@@ -0,0 +1,173 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidPatch = errors.New("invalid patch")
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// applyPatch applies operations in order to u. Attributes that are not stored,
+// and `emails` which always mirrors userName, are ignored as RFC 7644 allows a
+// service provider to do.
+func applyPatch(u *User, ops []PatchOperation) error {
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				if err := replaceAll(u, op.Value); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := replaceAttr(u, op.Path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return fmt.Errorf("%w: remove requires a path", ErrInvalidPatch)
+			}
+			if err := removeAttr(u, op.Path); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: unknown op %q", ErrInvalidPatch, op.Op)
+		}
+	}
+
+	return nil
+}
+
+// replaceAll handles an operation without a path, whose value is an object of
+// attributes. Azure AD sends sub-attributes as dotted keys, e.g. `name.givenName`.
+func replaceAll(u *User, value json.RawMessage) error {
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(value, &attrs); err != nil {
+		return fmt.Errorf("%w: value must be an object", ErrInvalidPatch)
+	}
+
+	for path, v := range attrs {
+		if err := replaceAttr(u, path, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceAttr(u *User, path string, value json.RawMessage) error {
+	path = strings.TrimPrefix(strings.ToLower(path), strings.ToLower(SchemaUser)+":")
+
+	switch path {
+	case "name":
+		var name map[string]json.RawMessage
+		if err := json.Unmarshal(value, &name); err != nil {
+			return fmt.Errorf("%w: name must be an object", ErrInvalidPatch)
+		}
+		for sub, v := range name {
+			if err := replaceAttr(u, "name."+sub, v); err != nil {
+				return err
+			}
+		}
+	case attrUserName:
+		s, err := patchString(path, value)
+		if err != nil {
+			return err
+		}
+		if s == "" {
+			return fmt.Errorf("%w: userName must not be empty", ErrInvalidPatch)
+		}
+		u.UserName = s
+	case attrExternalID:
+		return setString(&u.ExternalID, path, value)
+	case attrGivenName:
+		return setString(&u.GivenName, path, value)
+	case attrMiddleName:
+		return setString(&u.MiddleName, path, value)
+	case attrFamilyName:
+		return setString(&u.FamilyName, path, value)
+	case attrActive:
+		active, err := patchBool(value)
+		if err != nil {
+			return err
+		}
+		u.Active = active
+	}
+
+	return nil
+}
+
+func removeAttr(u *User, path string) error {
+	path = strings.TrimPrefix(strings.ToLower(path), strings.ToLower(SchemaUser)+":")
+
+	switch path {
+	case attrUserName, attrActive:
+		return fmt.Errorf("%w: %s cannot be removed", ErrInvalidPatch, path)
+	case attrExternalID:
+		u.ExternalID = ""
+	case "name":
+		u.GivenName, u.MiddleName, u.FamilyName = "", "", ""
+	case attrGivenName:
+		u.GivenName = ""
+	case attrMiddleName:
+		u.MiddleName = ""
+	case attrFamilyName:
+		u.FamilyName = ""
+	}
+
+	return nil
+}
+
+func setString(dst *string, path string, value json.RawMessage) error {
+	s, err := patchString(path, value)
+	if err != nil {
+		return err
+	}
+	*dst = s
+	return nil
+}
+
+func patchString(path string, value json.RawMessage) (string, error) {
+	var s *string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", fmt.Errorf("%w: %s must be a string", ErrInvalidPatch, path)
+	}
+	if s == nil {
+		return "", nil
+	}
+	return *s, nil
+}
+
+// patchBool accepts a string as well because some clients, Azure AD among
+// them, send `"active": "False"`.
+func patchBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return false, fmt.Errorf("%w: active must be a boolean", ErrInvalidPatch)
+}
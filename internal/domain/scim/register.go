@@ -0,0 +1,24 @@
+package scim
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+// RegisterHTTPEndPoints registers SCIM 2.0 routes under /scim/v2. publicURL is
+// used to build the location of each resource.
+func RegisterHTTPEndPoints(router *chi.Mux, cfg config.Scim, publicURL string, repo Repo) {
+	h := NewHandler(repo, publicURL+"/scim/v2")
+
+	router.Route("/scim/v2", func(router chi.Router) {
+		router.Use(Authenticate(cfg.Tokens))
+		router.Get("/ServiceProviderConfig", h.ServiceProviderConfig)
+		router.Get("/Users", h.List)
+		router.Post("/Users", h.Create)
+		router.Get("/Users/{userID}", h.Get)
+		router.Put("/Users/{userID}", h.Replace)
+		router.Patch("/Users/{userID}", h.Patch)
+		router.Delete("/Users/{userID}", h.Delete)
+	})
+}
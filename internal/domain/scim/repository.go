@@ -0,0 +1,417 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/ent/gen/predicate"
+	"github.com/gmhafiz/go8/ent/gen/user"
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+var (
+	ErrUserNotFound    = errors.New("user not found")
+	ErrUserNameTaken   = errors.New("userName is already in use")
+	ErrExternalIDTaken = errors.New("externalId is already in use")
+)
+
+type Repo interface {
+	// List returns users matching f, which may be nil, starting at offset. It
+	// also returns the total number of matching users.
+	List(ctx context.Context, f *Filter, offset, limit int) ([]*User, int, error)
+	Read(ctx context.Context, userID uint64) (*User, error)
+	Create(ctx context.Context, u *User) (*User, error)
+	// Replace overwrites every SCIM managed attribute of the user. Deactivating
+	// a user also revokes all of their sessions.
+	Replace(ctx context.Context, u *User) (*User, error)
+	Delete(ctx context.Context, userID uint64) error
+}
+
+type repo struct {
+	ent      *gen.Client
+	sessions middleware.UserSessionStore
+}
+
+func NewRepo(ent *gen.Client, manager *scs.SessionManager) *repo {
+	sessions, _ := manager.CtxStore.(middleware.UserSessionStore)
+
+	return &repo{
+		ent:      ent,
+		sessions: sessions,
+	}
+}
+
+func (r *repo) List(ctx context.Context, f *Filter, offset, limit int) ([]*User, int, error) {
+	var predicates []predicate.User
+	if f != nil {
+		p, err := userPredicate(f)
+		if err != nil {
+			return nil, 0, err
+		}
+		predicates = append(predicates, p)
+	}
+
+	total, err := r.ent.User.Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get total user records: %w", err)
+	}
+
+	users, err := r.ent.User.Query().
+		Where(predicates...).
+		Order(user.ByID()).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get user records: %w", err)
+	}
+
+	resources := make([]*User, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, fromEnt(u))
+	}
+
+	return resources, total, nil
+}
+
+func (r *repo) Read(ctx context.Context, userID uint64) (*User, error) {
+	u, err := r.ent.User.Get(ctx, userID)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return fromEnt(u), nil
+}
+
+// Create adds a user without a password. The identity provider has already
+// verified the email address, and the user logs in through it, a magic link or
+// by resetting their password.
+func (r *repo) Create(ctx context.Context, u *User) (*User, error) {
+	if err := r.checkUnique(ctx, u); err != nil {
+		return nil, err
+	}
+
+	create := r.ent.User.Create().
+		SetEmail(u.UserName).
+		SetPassword("").
+		SetFirstName(u.GivenName).
+		SetMiddleName(u.MiddleName).
+		SetLastName(u.FamilyName).
+		SetVerifiedAt(time.Now())
+	if u.ExternalID != "" {
+		create.SetExternalID(u.ExternalID)
+	}
+	if !u.Active {
+		create.SetDisabledAt(time.Now())
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromEnt(created), nil
+}
+
+func (r *repo) Replace(ctx context.Context, u *User) (*User, error) {
+	current, err := r.ent.User.Get(ctx, u.ID)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := r.checkUnique(ctx, u); err != nil {
+		return nil, err
+	}
+
+	update := r.ent.User.UpdateOneID(u.ID).
+		SetEmail(u.UserName).
+		SetFirstName(u.GivenName).
+		SetMiddleName(u.MiddleName).
+		SetLastName(u.FamilyName)
+	if u.ExternalID != "" {
+		update.SetExternalID(u.ExternalID)
+	} else {
+		update.ClearExternalID()
+	}
+
+	// Keeps the original time a user was disabled at.
+	deactivated := !u.Active && current.DisabledAt == nil
+	if u.Active {
+		update.ClearDisabledAt()
+	} else if deactivated {
+		update.SetDisabledAt(time.Now())
+	}
+
+	updated, err := update.Save(ctx)
+	if gen.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if deactivated && r.sessions != nil {
+		if _, err := r.sessions.DeleteAllByUserCtx(ctx, u.ID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return fromEnt(updated), nil
+}
+
+func (r *repo) Delete(ctx context.Context, userID uint64) error {
+	if r.sessions != nil {
+		if _, err := r.sessions.DeleteAllByUserCtx(ctx, userID, ""); err != nil {
+			return err
+		}
+	}
+
+	err := r.ent.User.DeleteOneID(userID).Exec(ctx)
+	if gen.IsNotFound(err) {
+		return ErrUserNotFound
+	}
+	return err
+}
+
+// checkUnique reports a conflict before the unique constraint does, so that the
+// client is told which attribute clashes.
+func (r *repo) checkUnique(ctx context.Context, u *User) error {
+	taken, err := r.ent.User.Query().
+		Where(user.EmailEqualFold(u.UserName), user.IDNEQ(u.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrUserNameTaken
+	}
+
+	if u.ExternalID == "" {
+		return nil
+	}
+	taken, err = r.ent.User.Query().
+		Where(user.ExternalID(u.ExternalID), user.IDNEQ(u.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrExternalIDTaken
+	}
+
+	return nil
+}
+
+func fromEnt(u *gen.User) *User {
+	resource := &User{
+		ID:         u.ID,
+		UserName:   u.Email,
+		GivenName:  u.FirstName,
+		MiddleName: u.MiddleName,
+		FamilyName: u.LastName,
+		Active:     u.DisabledAt == nil,
+	}
+	if u.ExternalID != nil {
+		resource.ExternalID = *u.ExternalID
+	}
+
+	return resource
+}
+
+// userPredicate translates a filter into a query. userName, emails and names
+// are compared case-insensitively, externalId is case-exact as RFC 7643 says.
+func userPredicate(f *Filter) (predicate.User, error) {
+	switch f.Op {
+	case "and", "or":
+		left, err := userPredicate(f.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := userPredicate(f.Right)
+		if err != nil {
+			return nil, err
+		}
+		if f.Op == "and" {
+			return user.And(left, right), nil
+		}
+		return user.Or(left, right), nil
+	case "not":
+		inner, err := userPredicate(f.Left)
+		if err != nil {
+			return nil, err
+		}
+		return user.Not(inner), nil
+	}
+
+	switch f.Attr {
+	case attrID:
+		return idPredicate(f)
+	case attrActive:
+		return activePredicate(f)
+	case attrUserName, attrEmails:
+		return stringPredicate(user.FieldEmail, f, false)
+	case attrGivenName:
+		return stringPredicate(user.FieldFirstName, f, false)
+	case attrMiddleName:
+		return stringPredicate(user.FieldMiddleName, f, false)
+	case attrFamilyName:
+		return stringPredicate(user.FieldLastName, f, false)
+	case attrExternalID:
+		return stringPredicate(user.FieldExternalID, f, true)
+	}
+
+	return nil, fmt.Errorf("%w: unknown attribute %q", ErrInvalidFilter, f.Attr)
+}
+
+// always matches every user, since id and active are always present.
+func always(s *sql.Selector) {
+	s.Where(sql.Not(sql.False()))
+}
+
+func idPredicate(f *Filter) (predicate.User, error) {
+	if f.Op == "pr" {
+		return always, nil
+	}
+
+	// id is a string in SCIM, but only numeric ids can ever match.
+	s, ok := f.Value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: id must be compared with a string", ErrInvalidFilter)
+	}
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil && f.Op == "ne" {
+		return always, nil
+	} else if err != nil {
+		return user.Not(always), nil
+	}
+
+	switch f.Op {
+	case "eq":
+		return user.ID(id), nil
+	case "ne":
+		return user.IDNEQ(id), nil
+	case "gt":
+		return user.IDGT(id), nil
+	case "ge":
+		return user.IDGTE(id), nil
+	case "lt":
+		return user.IDLT(id), nil
+	case "le":
+		return user.IDLTE(id), nil
+	}
+
+	return nil, fmt.Errorf("%w: operator %s is not supported on id", ErrInvalidFilter, f.Op)
+}
+
+func activePredicate(f *Filter) (predicate.User, error) {
+	if f.Op == "pr" {
+		return always, nil
+	}
+
+	active, ok := f.Value.(bool)
+	if !ok {
+		return nil, fmt.Errorf("%w: active must be compared with a boolean", ErrInvalidFilter)
+	}
+
+	switch f.Op {
+	case "eq":
+	case "ne":
+		active = !active
+	default:
+		return nil, fmt.Errorf("%w: operator %s is not supported on active", ErrInvalidFilter, f.Op)
+	}
+
+	if active {
+		return user.DisabledAtIsNil(), nil
+	}
+	return user.DisabledAtNotNil(), nil
+}
+
+func stringPredicate(column string, f *Filter, caseExact bool) (predicate.User, error) {
+	if f.Op == "pr" {
+		return func(s *sql.Selector) {
+			s.Where(sql.And(sql.NotNull(s.C(column)), sql.NEQ(s.C(column), "")))
+		}, nil
+	}
+
+	v, ok := f.Value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be compared with a string", ErrInvalidFilter, f.Attr)
+	}
+
+	return func(s *sql.Selector) {
+		col := s.C(column)
+
+		var p *sql.Predicate
+		switch f.Op {
+		case "eq":
+			p = sql.EQ(col, v)
+			if !caseExact {
+				p = sql.EqualFold(col, v)
+			}
+		case "ne":
+			p = sql.NEQ(col, v)
+			if !caseExact {
+				p = sql.Not(sql.EqualFold(col, v))
+			}
+			p = sql.Or(sql.IsNull(col), p)
+		case "co":
+			p = like(col, "%"+escapeLike(v)+"%", caseExact)
+		case "sw":
+			p = like(col, escapeLike(v)+"%", caseExact)
+		case "ew":
+			p = like(col, "%"+escapeLike(v), caseExact)
+		default:
+			p = compare(col, f.Op, v, caseExact)
+		}
+
+		s.Where(p)
+	}, nil
+}
+
+func like(col, pattern string, caseExact bool) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		if caseExact {
+			b.Ident(col).WriteString(" LIKE ")
+		} else {
+			b.Ident(col).WriteString(" ILIKE ")
+		}
+		b.Arg(pattern)
+	})
+}
+
+var comparisons = map[string]string{
+	"gt": " > ",
+	"ge": " >= ",
+	"lt": " < ",
+	"le": " <= ",
+}
+
+func compare(col, op, v string, caseExact bool) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		if caseExact {
+			b.Ident(col).WriteString(comparisons[op]).Arg(v)
+			return
+		}
+		b.WriteString("LOWER(").Ident(col).WriteString(")").
+			WriteString(comparisons[op]).
+			WriteString("LOWER(").Arg(v).WriteString(")")
+	})
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
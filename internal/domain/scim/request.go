@@ -0,0 +1,39 @@
+package scim
+
+import (
+	"strings"
+)
+
+// UserRequest is the body of POST and PUT requests. Attributes this service
+// does not store are ignored.
+type UserRequest struct {
+	Schemas    []string `json:"schemas"`
+	ExternalID string   `json:"externalId"`
+	UserName   string   `json:"userName"`
+	Name       Name     `json:"name"`
+	Emails     []Email  `json:"emails"`
+	Active     *bool    `json:"active"`
+}
+
+// user maps the request onto a User. A missing userName falls back to the
+// primary email, and a missing active means active.
+func (r UserRequest) user() *User {
+	u := &User{
+		ExternalID: r.ExternalID,
+		UserName:   strings.TrimSpace(r.UserName),
+		GivenName:  r.Name.GivenName,
+		MiddleName: r.Name.MiddleName,
+		FamilyName: r.Name.FamilyName,
+		Active:     r.Active == nil || *r.Active,
+	}
+
+	if u.UserName == "" {
+		for _, email := range r.Emails {
+			if email.Primary || u.UserName == "" {
+				u.UserName = strings.TrimSpace(email.Value)
+			}
+		}
+	}
+
+	return u
+}
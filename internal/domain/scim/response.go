@@ -0,0 +1,110 @@
+package scim
+
+import (
+	"strconv"
+)
+
+type UserResponse struct {
+	Schemas    []string `json:"schemas"`
+	ID         string   `json:"id"`
+	ExternalID string   `json:"externalId,omitempty"`
+	UserName   string   `json:"userName"`
+	Name       Name     `json:"name"`
+	Emails     []Email  `json:"emails"`
+	Active     bool     `json:"active"`
+	Meta       Meta     `json:"meta"`
+}
+
+type Name struct {
+	GivenName  string `json:"givenName,omitempty"`
+	MiddleName string `json:"middleName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+type ListResponse struct {
+	Schemas      []string       `json:"schemas"`
+	TotalResults int            `json:"totalResults"`
+	StartIndex   int            `json:"startIndex"`
+	ItemsPerPage int            `json:"itemsPerPage"`
+	Resources    []UserResponse `json:"Resources"`
+}
+
+type ErrorResponse struct {
+	Schemas []string `json:"schemas"`
+	// Status is the HTTP status code as a string.
+	Status   string `json:"status"`
+	ScimType string `json:"scimType,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+func UserResource(u *User, baseURL string) UserResponse {
+	id := strconv.FormatUint(u.ID, 10)
+
+	return UserResponse{
+		Schemas:    []string{SchemaUser},
+		ID:         id,
+		ExternalID: u.ExternalID,
+		UserName:   u.UserName,
+		Name: Name{
+			GivenName:  u.GivenName,
+			MiddleName: u.MiddleName,
+			FamilyName: u.FamilyName,
+		},
+		Emails: []Email{{Value: u.UserName, Primary: true}},
+		Active: u.Active,
+		Meta: Meta{
+			ResourceType: "User",
+			Location:     baseURL + "/Users/" + id,
+		},
+	}
+}
+
+func UserResources(users []*User, baseURL string) []UserResponse {
+	resources := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, UserResource(u, baseURL))
+	}
+	return resources
+}
+
+// ServiceProviderConfig advertises which optional parts of SCIM are supported.
+// See RFC 7643 section 5.
+func ServiceProviderConfig(maxResults int) map[string]any {
+	unsupported := map[string]bool{"supported": false}
+
+	return map[string]any{
+		"schemas": []string{SchemaServiceProviderConfig},
+		"patch":   map[string]bool{"supported": true},
+		"bulk": map[string]any{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]any{
+			"supported":  true,
+			"maxResults": maxResults,
+		},
+		"changePassword": unsupported,
+		"sort":           unsupported,
+		"etag":           unsupported,
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "Authentication with a static token from SCIM_TOKENS.",
+			"primary":     true,
+		}},
+		"meta": map[string]string{
+			"resourceType": "ServiceProviderConfig",
+		},
+	}
+}
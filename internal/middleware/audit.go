@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -14,24 +15,32 @@ const (
 
 type Action string
 
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
 type Event struct {
-	ActorID    uint64    `db:"actor_id" json:"actor_id,omitempty"`
-	TableRowID int       `db:"table_row_id" json:"table_row_id,omitempty"`
-	Table      string    `db:"table_name" json:"table,omitempty"`
-	Action     Action    `db:"action" json:"action,omitempty"`
-	OldValues  string    `db:"old_values" json:"old_values,omitempty"`
-	NewValues  string    `db:"new_values" json:"new_values,omitempty"`
-	HTTPMethod string    `db:"http_method" json:"http_method,omitempty"`
-	URL        string    `db:"url" json:"url,omitempty"`
-	IPAddress  string    `db:"ip_address" json:"ip_address,omitempty"`
-	UserAgent  string    `db:"user_agent" json:"user_agent,omitempty"`
-	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	ActorID    uint64          `db:"actor_id" json:"actor_id,omitempty"`
+	TableRowID uint64          `db:"table_row_id" json:"table_row_id,omitempty"`
+	Table      string          `db:"table_name" json:"table,omitempty"`
+	Action     Action          `db:"action" json:"action,omitempty"`
+	OldValues  json.RawMessage `db:"old_values" json:"old_values,omitempty"`
+	NewValues  json.RawMessage `db:"new_values" json:"new_values,omitempty"`
+	HTTPMethod string          `db:"http_method" json:"http_method,omitempty"`
+	URL        string          `db:"url" json:"url,omitempty"`
+	IPAddress  string          `db:"ip_address" json:"ip_address,omitempty"`
+	UserAgent  string          `db:"user_agent" json:"user_agent,omitempty"`
+	CreatedAt  time.Time       `db:"created_at" json:"created_at"`
 }
 
+// Audit saves where a request comes from into its context, for changes made
+// during the request to be recorded with. Actor is left out because the user is
+// only known once Authenticate runs. Use EventFromContext to get both.
 func Audit(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ev := Event{
-			ActorID:    getUserID(r),
 			HTTPMethod: r.Method,
 			URL:        r.RequestURI,
 			IPAddress:  readUserIP(r),
@@ -44,21 +53,15 @@ func Audit(next http.Handler) http.Handler {
 	})
 }
 
-func getUserID(r *http.Request) uint64 {
-	userID, ok := r.Context().Value(KeySession).(uint64)
+// EventFromContext returns the request saved by Audit along with the user
+// authenticated by Authenticate, if any. It reports false outside of a request.
+func EventFromContext(ctx context.Context) (Event, bool) {
+	ev, ok := ctx.Value(KeyAuditID).(Event)
 	if !ok {
-		return 0
-	}
-	return userID
-}
-
-func readUserIP(r *http.Request) string {
-	ipAddress := r.Header.Get("X-Real-Ip")
-	if ipAddress == "" {
-		ipAddress = r.Header.Get("X-Forwarded-For")
+		return Event{}, false
 	}
-	if ipAddress == "" {
-		ipAddress = r.RemoteAddr
+	if userID, ok := UserID(ctx); ok {
+		ev.ActorID = userID
 	}
-	return ipAddress
+	return ev, true
 }
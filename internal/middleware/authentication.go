@@ -2,46 +2,235 @@ package middleware
 
 import (
 	"bufio"
-	"bytes"
 	"context"
 	"net"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gmhafiz/scs/v2"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
 )
 
 const (
-	KeyID      key = "id"
-	KeySession key = "session"
+	KeyID     key = "id"
+	KeyScopes key = "scopes"
+	KeyRoles  key = "roles"
+	// KeyAuthTime is the session key holding when user last entered their
+	// credentials, in unix seconds.
+	KeyAuthTime key = "auth_time"
+
+	// ScopeRead allows a bearer token to make safe (GET, HEAD, OPTIONS) requests.
+	ScopeRead = "read"
+	// ScopeWrite allows a bearer token to make data modifying requests.
+	ScopeWrite = "write"
+
+	// RoleAdmin allows managing other users.
+	RoleAdmin = "admin"
+	// RoleInviter allows inviting people to register.
+	RoleInviter = "inviter"
 )
 
+// TokenStore finds the owner of a bearer token. Implementations are expected
+// to record when the token was last used, though not necessarily on every
+// request.
+type TokenStore interface {
+	FindToken(ctx context.Context, token string) (userID uint64, scopes []string, found bool, err error)
+}
+
+// UserStore finds the roles of a user and whether the account is disabled. It
+// is consulted on every request so that disabling a user takes effect even on
+// sessions and tokens that are still live.
+type UserStore interface {
+	FindUser(ctx context.Context, userID uint64) (roles []string, disabled, found bool, err error)
+}
+
+type authConfig struct {
+	tokens TokenStore
+	users  UserStore
+}
+
+type AuthOption func(cfg *authConfig)
+
+// WithTokenStore accepts `Authorization: Bearer <token>` as an alternative to
+// the session cookie.
+func WithTokenStore(store TokenStore) AuthOption {
+	return func(cfg *authConfig) {
+		cfg.tokens = store
+	}
+}
+
+// WithUserStore rejects disabled users and makes their roles available to
+// RequireRole.
+func WithUserStore(store UserStore) AuthOption {
+	return func(cfg *authConfig) {
+		cfg.users = store
+	}
+}
+
 // Authenticate simply checks is current user is logged in by checking token validity in
-// cookie
-func Authenticate(m *scs.SessionManager) func(http.Handler) http.Handler {
+// cookie, or in the Authorization header if a TokenStore is given. Logged-in user ID is
+// saved into request context. To access user ID:
+//
+//	userID, ok := middleware.UserID(ctx)
+func Authenticate(m *scs.SessionManager, opts ...AuthOption) func(http.Handler) http.Handler {
+	cfg := &authConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
+
+			if bearer, ok := bearerToken(r); ok && cfg.tokens != nil {
+				userID, scopes, found, err := cfg.tokens.FindToken(ctx, bearer)
+				if err != nil {
+					respond.Error(w, http.StatusInternalServerError, err)
+					return
+				}
+				if !found {
+					respond.Status(w, http.StatusUnauthorized)
+					return
+				}
+				if !allowedByScopes(r.Method, scopes) {
+					respond.Status(w, http.StatusForbidden)
+					return
+				}
+
+				ctx, status := cfg.loadUser(ctx, userID)
+				if status != 0 {
+					respond.Status(w, status)
+					return
+				}
+
+				ctx = context.WithValue(ctx, KeyID, userID)
+				ctx = context.WithValue(ctx, KeyScopes, scopes)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			token := m.Token(ctx)
 			if token == "" {
-				w.WriteHeader(http.StatusUnauthorized)
+				respond.Status(w, http.StatusUnauthorized)
 				return
 			}
 
-			_, found, err := m.CtxStore.FindCtx(r.Context(), token)
+			var found bool
+			var err error
+			if toucher, ok := m.CtxStore.(SessionToucher); ok {
+				found, err = toucher.TouchCtx(ctx, token)
+			} else {
+				_, found, err = m.CtxStore.FindCtx(ctx, token)
+			}
 			if err != nil {
+				respond.Error(w, http.StatusInternalServerError, err)
 				return
 			}
 			if !found {
-				w.WriteHeader(http.StatusUnauthorized)
+				respond.Status(w, http.StatusUnauthorized)
 				return
 			}
 
+			if userID, ok := m.Get(ctx, string(KeyID)).(uint64); ok {
+				var status int
+				ctx, status = cfg.loadUser(ctx, userID)
+				if status != 0 {
+					if status == http.StatusUnauthorized {
+						_ = m.Destroy(ctx)
+					}
+					respond.Status(w, status)
+					return
+				}
+				ctx = context.WithValue(ctx, KeyID, userID)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// loadUser adds roles of the user into context. A non-zero status is returned
+// when the user must be rejected.
+func (cfg *authConfig) loadUser(ctx context.Context, userID uint64) (context.Context, int) {
+	if cfg.users == nil {
+		return ctx, 0
+	}
+
+	roles, disabled, found, err := cfg.users.FindUser(ctx, userID)
+	if err != nil {
+		return ctx, http.StatusInternalServerError
+	}
+	if !found || disabled {
+		return ctx, http.StatusUnauthorized
+	}
+
+	return context.WithValue(ctx, KeyRoles, roles), 0
+}
+
+// RequireRole only lets through users having the given role. It must come after
+// Authenticate with a UserStore.
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !HasRole(r.Context(), role) {
+				respond.Status(w, http.StatusForbidden)
+				return
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// HasRole reports whether the authenticated user has the given role.
+func HasRole(ctx context.Context, role string) bool {
+	roles, _ := ctx.Value(KeyRoles).([]string)
+	return slices.Contains(roles, role)
+}
+
+// UserID returns the ID of the user authenticated by Authenticate middleware.
+func UserID(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(KeyID).(uint64)
+	return userID, ok
+}
+
+// HasScope reports whether current request is allowed the given scope. Requests
+// authenticated with a session cookie are allowed every scope.
+func HasScope(ctx context.Context, scope string) bool {
+	scopes, ok := ctx.Value(KeyScopes).([]string)
+	if !ok {
+		return true
+	}
+	return slices.Contains(scopes, scope)
+}
+
+// ViaToken reports whether current request was authenticated with a bearer
+// token rather than a session cookie.
+func ViaToken(ctx context.Context) bool {
+	_, ok := ctx.Value(KeyScopes).([]string)
+	return ok
+}
+
+func allowedByScopes(method string, scopes []string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return slices.Contains(scopes, ScopeRead) || slices.Contains(scopes, ScopeWrite)
+	default:
+		return slices.Contains(scopes, ScopeWrite)
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
 // LoadAndSave is a custom middleware adapted from scs library that saves logged in user ID
 // into request context. To access user ID:
 //
@@ -49,7 +238,20 @@ func Authenticate(m *scs.SessionManager) func(http.Handler) http.Handler {
 //			if !ok {
 //	         // no user ID saved into context
 //			}
-func LoadAndSave(s *scs.SessionManager) func(http.Handler) http.Handler {
+//
+// The session is saved, and its cookie set, just before the response header
+// is sent on the first WriteHeader, Write or Flush, or when the handler
+// returns without writing. The response itself is not held back, so handlers
+// may stream it. Changes to the session after that are not saved, so make
+// them before writing.
+//
+// Timeouts and the number of sessions per user are limited by SessionOption.
+func LoadAndSave(s *scs.SessionManager, opts ...SessionOption) func(http.Handler) http.Handler {
+	cfg := &sessionConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			var token string
@@ -58,76 +260,148 @@ func LoadAndSave(s *scs.SessionManager) func(http.Handler) http.Handler {
 				token = cookie.Value
 			}
 
-			ctx, err := s.Load(r.Context(), token)
+			ctx := context.WithValue(r.Context(), KeyClient, newClient(r))
+			ctx, err = s.Load(ctx, token)
 			if err != nil {
 				s.ErrorFunc(w, r, err)
 				return
 			}
 
+			if cfg.expired(ctx, s) {
+				if err := s.Destroy(ctx); err != nil {
+					s.ErrorFunc(w, r, err)
+					return
+				}
+			}
+			loadedToken := s.Token(ctx)
+
 			sr := r.WithContext(ctx)
-			bw := &bufferedResponseWriter{ResponseWriter: w}
-			next.ServeHTTP(bw, sr)
+			sw := &sessionWriter{
+				ResponseWriter: w,
+				commit: func() error {
+					return cfg.save(ctx, s, w, loadedToken)
+				},
+				fail: func(err error) {
+					s.ErrorFunc(w, sr, err)
+				},
+			}
+			next.ServeHTTP(sw, sr)
 
 			if sr.MultipartForm != nil {
 				_ = sr.MultipartForm.RemoveAll()
 			}
 
-			var userID any
-			userID, ok := s.Get(ctx, string(KeyID)).(uint64)
-			if !ok {
-				userID = nil
-			}
-			ctx = context.WithValue(ctx, KeyID, userID)
+			sw.save()
+		})
+	}
+}
 
-			switch s.Status(ctx) {
-			case scs.Modified:
-				token, expiry, err := s.Commit(ctx)
-				if err != nil {
-					s.ErrorFunc(w, r, err)
-					return
-				}
+// save commits the session and sets its cookie on w.
+func (cfg *sessionConfig) save(ctx context.Context, s *scs.SessionManager, w http.ResponseWriter, loadedToken string) error {
+	var userID any
+	userID, ok := s.Get(ctx, string(KeyID)).(uint64)
+	if !ok {
+		userID = nil
+	}
+	ctx = context.WithValue(ctx, KeyID, userID)
 
-				s.WriteSessionCookie(ctx, w, token, expiry)
-			case scs.Destroyed:
-				s.WriteSessionCookie(ctx, w, "", time.Time{})
-			}
+	// A new token with a user means the user has just logged in.
+	loggedIn := ok && s.Token(ctx) != loadedToken
+	if ok {
+		cfg.touch(ctx, s, loggedIn)
+	}
 
-			w.Header().Add("Vary", "Cookie")
+	switch s.Status(ctx) {
+	case scs.Modified:
+		token, expiry, err := cfg.commit(ctx, s)
+		if err != nil {
+			return err
+		}
 
-			if bw.code != 0 {
-				w.WriteHeader(bw.code)
+		s.WriteSessionCookie(ctx, w, token, expiry)
+
+		if loggedIn {
+			if err := cfg.evict(ctx, s, userID.(uint64), token); err != nil {
+				return err
 			}
-			_, _ = w.Write(bw.buf.Bytes())
-		})
+		}
+	case scs.Destroyed:
+		s.WriteSessionCookie(ctx, w, "", time.Time{})
 	}
+
+	respond.AddVary(w.Header(), "Cookie")
+
+	return nil
 }
 
-type bufferedResponseWriter struct {
+// sessionWriter saves the session once, right before the response header is
+// sent.
+type sessionWriter struct {
 	http.ResponseWriter
-	buf         bytes.Buffer
-	code        int
-	wroteHeader bool
+	commit func() error
+	fail   func(err error)
+
+	saved bool
+	// err is why the session could not be saved, in which case the error
+	// has been sent instead of the response.
+	err error
 }
 
-func (bw *bufferedResponseWriter) Write(b []byte) (int, error) {
-	return bw.buf.Write(b)
+func (sw *sessionWriter) save() {
+	if sw.saved {
+		return
+	}
+	sw.saved = true
+
+	if err := sw.commit(); err != nil {
+		sw.err = err
+		sw.fail(err)
+	}
 }
 
-func (bw *bufferedResponseWriter) WriteHeader(code int) {
-	if !bw.wroteHeader {
-		bw.code = code
-		bw.wroteHeader = true
+func (sw *sessionWriter) WriteHeader(code int) {
+	if code >= http.StatusOK {
+		sw.save()
+	}
+	if sw.err != nil {
+		return
 	}
+	sw.ResponseWriter.WriteHeader(code)
 }
 
-func (bw *bufferedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
-	hj := bw.ResponseWriter.(http.Hijacker)
-	return hj.Hijack()
+func (sw *sessionWriter) Write(b []byte) (int, error) {
+	sw.save()
+	if sw.err != nil {
+		return 0, sw.err
+	}
+	return sw.ResponseWriter.Write(b)
 }
 
-func (bw *bufferedResponseWriter) Push(target string, opts *http.PushOptions) error {
-	if pusher, ok := bw.ResponseWriter.(http.Pusher); ok {
+func (sw *sessionWriter) Flush() {
+	sw.save()
+	if sw.err != nil {
+		return
+	}
+	_ = http.NewResponseController(sw.ResponseWriter).Flush()
+}
+
+// Hijack saves the session first, though its cookie can only be sent by
+// whoever takes over the connection.
+func (sw *sessionWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	sw.save()
+	if sw.err != nil {
+		return nil, nil, sw.err
+	}
+	return http.NewResponseController(sw.ResponseWriter).Hijack()
+}
+
+func (sw *sessionWriter) Push(target string, opts *http.PushOptions) error {
+	if pusher, ok := sw.ResponseWriter.(http.Pusher); ok {
 		return pusher.Push(target, opts)
 	}
 	return http.ErrNotSupported
 }
+
+func (sw *sessionWriter) Unwrap() http.ResponseWriter {
+	return sw.ResponseWriter
+}
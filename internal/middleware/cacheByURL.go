@@ -3,10 +3,11 @@ package middleware
 import (
 	"context"
 	"encoding/hex"
-	"log"
 	"net/http"
 
 	"github.com/cespare/xxhash/v2"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
 )
 
 type cacheKey string
@@ -27,9 +28,7 @@ func CacheByURL(next http.Handler) http.Handler {
 		//      }
 		str, err := sum(r.URL.String())
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(`{"message":"internal error"}`))
+			respond.Error(w, http.StatusInternalServerError, err)
 			return
 		}
 
@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+func TestClientIP(t *testing.T) {
+	trusted, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		xForwardedFor []string
+		xRealIP       string
+		want          string
+	}{
+		{
+			name:       "direct",
+			remoteAddr: "198.51.100.1:1234",
+			want:       "ip:198.51.100.1",
+		},
+		{
+			name:          "headers from a client are ignored",
+			remoteAddr:    "198.51.100.1:1234",
+			xForwardedFor: []string{"203.0.113.7"},
+			xRealIP:       "203.0.113.8",
+			want:          "ip:198.51.100.1",
+		},
+		{
+			name:          "behind a trusted proxy",
+			remoteAddr:    "10.0.0.2:1234",
+			xForwardedFor: []string{"203.0.113.7"},
+			want:          "ip:203.0.113.7",
+		},
+		{
+			name:          "spoofed behind a trusted proxy",
+			remoteAddr:    "10.0.0.2:1234",
+			xForwardedFor: []string{"1.2.3.4, 203.0.113.7"},
+			want:          "ip:203.0.113.7",
+		},
+		{
+			name:          "behind a chain of trusted proxies",
+			remoteAddr:    "192.0.2.10:1234",
+			xForwardedFor: []string{"203.0.113.7, 10.1.1.1", "10.0.0.3"},
+			want:          "ip:203.0.113.7",
+		},
+		{
+			name:       "x-real-ip from a trusted proxy",
+			remoteAddr: "10.0.0.2:1234",
+			xRealIP:    "203.0.113.8",
+			want:       "ip:203.0.113.8",
+		},
+		{
+			name:          "not an address",
+			remoteAddr:    "10.0.0.2:1234",
+			xForwardedFor: []string{"unknown"},
+			want:          "ip:10.0.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			h := middleware.ClientIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = middleware.KeyByIP(r)
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.xForwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if tt.xRealIP != "" {
+				r.Header.Set("X-Real-Ip", tt.xRealIP)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	_, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8", "::1", ""})
+	assert.Nil(t, err)
+
+	_, err = middleware.ParseTrustedProxies([]string{"proxy.internal"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,360 @@
+package middleware
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// Content codings that Compress supports.
+const (
+	EncodingBrotli = "br"
+	EncodingZstd   = "zstd"
+	EncodingGzip   = "gzip"
+)
+
+// DefaultCompressibleTypes are the media types Compress compresses unless
+// told otherwise. Images other than SVG, video and archives are compressed
+// already. Binary encodings such as msgpack and CBOR still repeat every field
+// name, so they shrink much like JSON does.
+var DefaultCompressibleTypes = []string{
+	"text/*",
+	"application/json",
+	"application/*+json",
+	"application/javascript",
+	"application/xml",
+	"application/*+xml",
+	"application/yaml",
+	"application/msgpack",
+	"application/x-msgpack",
+	"application/vnd.msgpack",
+	"application/cbor",
+	"image/svg+xml",
+}
+
+type compressConfig struct {
+	minSize      int
+	encodings    []string
+	contentTypes []string
+}
+
+type CompressOption func(*compressConfig)
+
+// WithMinSize sets the smallest body in bytes worth compressing. Smaller
+// bodies are sent as they are, because compressing them saves little and may
+// even make them larger. Defaults to 1024.
+func WithMinSize(n int) CompressOption {
+	return func(cfg *compressConfig) {
+		cfg.minSize = n
+	}
+}
+
+// WithEncodings sets the content codings to offer, most preferred first.
+// Defaults to br, zstd and gzip.
+func WithEncodings(encodings ...string) CompressOption {
+	return func(cfg *compressConfig) {
+		cfg.encodings = encodings
+	}
+}
+
+// WithContentTypes sets the media types to compress, such as text/*,
+// application/json or application/*+json. Defaults to
+// DefaultCompressibleTypes.
+func WithContentTypes(types ...string) CompressOption {
+	return func(cfg *compressConfig) {
+		cfg.contentTypes = types
+	}
+}
+
+// Compress compresses response bodies with the content coding the client
+// prefers in its Accept-Encoding header. A response is sent as it is when it
+// is smaller than the minimum size, its media type is not allowed, or the
+// handler has set Content-Encoding itself, such as when serving a file that
+// is compressed already.
+//
+// Compress may come before or after LoadAndSave. Headers are read when the
+// header is sent, by which time LoadAndSave has set the session cookie.
+func Compress(opts ...CompressOption) func(http.Handler) http.Handler {
+	cfg := &compressConfig{
+		minSize:      1024,
+		encodings:    []string{EncodingBrotli, EncodingZstd, EncodingGzip},
+		contentTypes: DefaultCompressibleTypes,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respond.AddVary(w.Header(), "Accept-Encoding")
+
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"), cfg.encodings)
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressWriter{
+				ResponseWriter: w,
+				cfg:            cfg,
+				encoding:       encoding,
+			}
+			defer cw.close()
+
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// negotiateEncoding returns the supported content coding given the highest
+// weight by an Accept-Encoding header, the earliest in supported on a tie, or
+// "" for none.
+func negotiateEncoding(acceptEncoding string, supported []string) string {
+	weights := make(map[string]float64)
+	wildcard := -1.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				q = 0
+			}
+		}
+
+		if name == "*" {
+			wildcard = q
+		} else {
+			weights[name] = q
+		}
+	}
+
+	var best string
+	var bestWeight float64
+	for _, encoding := range supported {
+		q, ok := weights[encoding]
+		if !ok && encoding == EncodingGzip {
+			q, ok = weights["x-gzip"]
+		}
+		if !ok {
+			q = wildcard
+		}
+		if q > bestWeight {
+			best, bestWeight = encoding, q
+		}
+	}
+	return best
+}
+
+// encoder is a pooled compressor of a content coding.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+var encoders = map[string]*sync.Pool{
+	EncodingBrotli: {New: func() any {
+		return brotli.NewWriterLevel(nil, brotli.DefaultCompression)
+	}},
+	EncodingZstd: {New: func() any {
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return enc
+	}},
+	EncodingGzip: {New: func() any {
+		enc, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return enc
+	}},
+}
+
+// compressWriter holds back the start of a body until there is enough of it
+// to decide whether to compress.
+type compressWriter struct {
+	http.ResponseWriter
+	cfg      *compressConfig
+	encoding string
+
+	code        int
+	wroteHeader bool
+	buf         []byte
+
+	// decided is whether the header has been sent, compressed if enc is set.
+	decided bool
+	enc     encoder
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if code < http.StatusOK {
+		// Informational responses such as 103 Early Hints come before the
+		// real one.
+		cw.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if cw.wroteHeader {
+		return
+	}
+	cw.code = code
+	cw.wroteHeader = true
+}
+
+func (cw *compressWriter) Write(b []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+
+	if cw.decided {
+		if cw.enc != nil {
+			return cw.enc.Write(b)
+		}
+		return cw.ResponseWriter.Write(b)
+	}
+
+	cw.buf = append(cw.buf, b...)
+	if len(cw.buf) >= cw.cfg.minSize {
+		if err := cw.decide(false); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// decide sends the header, compressing the body if it is worth it, then
+// whatever of the body was held back. A body flushed early is compressed
+// whatever its size, because more is on its way.
+func (cw *compressWriter) decide(flushing bool) error {
+	cw.decided = true
+	if !cw.wroteHeader {
+		cw.code = http.StatusOK
+	}
+
+	h := cw.Header()
+	if _, ok := h["Content-Type"]; !ok && len(cw.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+
+	if cw.compressible(flushing) {
+		h.Set("Content-Encoding", cw.encoding)
+		h.Del("Content-Length")
+		if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			// The compressed body is not the same bytes as the one the
+			// strong validator was made for.
+			h.Set("ETag", "W/"+etag)
+		}
+
+		cw.enc = encoders[cw.encoding].Get().(encoder)
+		cw.enc.Reset(cw.ResponseWriter)
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.code)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if cw.enc != nil {
+		_, err := cw.enc.Write(buf)
+		return err
+	}
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func (cw *compressWriter) compressible(flushing bool) bool {
+	if !flushing && len(cw.buf) < cw.cfg.minSize {
+		return false
+	}
+
+	switch cw.code {
+	case http.StatusNoContent, http.StatusPartialContent, http.StatusNotModified:
+		return false
+	}
+
+	h := cw.Header()
+	if h.Get("Content-Encoding") != "" || h.Get("Content-Range") != "" {
+		return false
+	}
+
+	mediaType, _, _ := strings.Cut(h.Get("Content-Type"), ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, pattern := range cw.cfg.contentTypes {
+		if matchMediaType(pattern, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// close sends whatever is held back and finishes the compressed body.
+func (cw *compressWriter) close() {
+	if !cw.decided && cw.wroteHeader {
+		_ = cw.decide(false)
+	}
+	if cw.enc == nil {
+		return
+	}
+
+	_ = cw.enc.Close()
+	// Keep the pool from holding on to the connection.
+	cw.enc.Reset(io.Discard)
+	encoders[cw.encoding].Put(cw.enc)
+	cw.enc = nil
+}
+
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		if !cw.wroteHeader {
+			cw.WriteHeader(http.StatusOK)
+		}
+		_ = cw.decide(true)
+	}
+	if cw.enc != nil {
+		_ = cw.enc.Flush()
+	}
+	_ = http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+func (cw *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(cw.ResponseWriter).Hijack()
+}
+
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// matchMediaType reports whether mediaType is matched by a pattern such as
+// text/*, application/*+json or application/json.
+func matchMediaType(pattern, mediaType string) bool {
+	typ, subtype, ok := strings.Cut(pattern, "/")
+	if !ok {
+		return false
+	}
+	gotType, gotSubtype, ok := strings.Cut(mediaType, "/")
+	if !ok || typ != gotType {
+		return false
+	}
+
+	switch {
+	case subtype == "*":
+		return true
+	case strings.HasPrefix(subtype, "*+"):
+		return strings.HasSuffix(gotSubtype, subtype[1:])
+	default:
+		return subtype == gotSubtype
+	}
+}
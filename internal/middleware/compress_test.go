@@ -0,0 +1,267 @@
+package middleware_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+var largeJSON = `[` + strings.Repeat(`{"first_name":"First","last_name":"Last"},`, 100) + `{}]`
+
+func serve(h http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/author", nil)
+	if acceptEncoding != "" {
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+	return rr
+}
+
+func writing(contentType, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		// In small pieces, as encoders do.
+		for chunk := range chunks(body, 100) {
+			_, _ = w.Write([]byte(chunk))
+		}
+	})
+}
+
+func chunks(s string, n int) func(func(string) bool) {
+	return func(yield func(string) bool) {
+		for len(s) > n {
+			if !yield(s[:n]) {
+				return
+			}
+			s = s[n:]
+		}
+		yield(s)
+	}
+}
+
+func decode(t *testing.T, encoding string, body []byte) string {
+	t.Helper()
+
+	var r io.Reader
+	var err error
+	switch encoding {
+	case middleware.EncodingBrotli:
+		r = brotli.NewReader(bytes.NewReader(body))
+	case middleware.EncodingZstd:
+		var dec *zstd.Decoder
+		dec, err = zstd.NewReader(bytes.NewReader(body))
+		if err == nil {
+			defer dec.Close()
+		}
+		r = dec
+	case middleware.EncodingGzip:
+		r, err = gzip.NewReader(bytes.NewReader(body))
+	default:
+		return string(body)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(plain)
+}
+
+func TestCompress(t *testing.T) {
+	h := middleware.Compress()(writing("application/json", largeJSON))
+
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "gzip, deflate, br, zstd", want: middleware.EncodingBrotli},
+		{acceptEncoding: "gzip, zstd", want: middleware.EncodingZstd},
+		{acceptEncoding: "gzip", want: middleware.EncodingGzip},
+		{acceptEncoding: "x-gzip", want: middleware.EncodingGzip},
+		{acceptEncoding: "br;q=0.5, gzip;q=1.0", want: middleware.EncodingGzip},
+		{acceptEncoding: "br;q=0, *", want: middleware.EncodingZstd},
+		{acceptEncoding: "*;q=0", want: ""},
+		{acceptEncoding: "identity", want: ""},
+		{acceptEncoding: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			rr := serve(h, tt.acceptEncoding)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.want, rr.Header().Get("Content-Encoding"))
+			assert.Equal(t, []string{"Accept-Encoding"}, rr.Header().Values("Vary"))
+			assert.Equal(t, largeJSON, decode(t, tt.want, rr.Body.Bytes()))
+			if tt.want != "" {
+				assert.Less(t, rr.Body.Len(), len(largeJSON))
+			}
+		})
+	}
+}
+
+func TestCompress_Skipped(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.Handler
+		opts    []middleware.CompressOption
+	}{
+		{
+			name:    "below minimum size",
+			handler: writing("application/json", `{"id":1}`),
+		},
+		{
+			name:    "below configured minimum size",
+			handler: writing("application/json", largeJSON),
+			opts:    []middleware.CompressOption{middleware.WithMinSize(len(largeJSON) + 1)},
+		},
+		{
+			name:    "media type not allowed",
+			handler: writing("image/png", largeJSON),
+		},
+		{
+			name:    "media type not configured",
+			handler: writing("application/json", largeJSON),
+			opts:    []middleware.CompressOption{middleware.WithContentTypes("text/*")},
+		},
+		{
+			name: "encoded already",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "gzip")
+				_, _ = w.Write([]byte(largeJSON))
+			}),
+		},
+		{
+			name: "no content",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			rr := serve(middleware.Compress(tt.opts...)(tt.handler), "br, gzip")
+
+			assert.Equal(t, rec.Code, rr.Code)
+			assert.Equal(t, rec.Header().Get("Content-Encoding"), rr.Header().Get("Content-Encoding"))
+			assert.Equal(t, rec.Body.String(), rr.Body.String())
+		})
+	}
+}
+
+func TestCompress_ETag(t *testing.T) {
+	h := middleware.Compress()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Length", "4000")
+		_, _ = w.Write([]byte(largeJSON))
+	}))
+
+	rr := serve(h, "gzip")
+
+	assert.Equal(t, `W/"v1"`, rr.Header().Get("ETag"))
+	assert.Empty(t, rr.Header().Get("Content-Length"))
+}
+
+func TestCompress_Flush(t *testing.T) {
+	h := middleware.Compress()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: 1\n\n"))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("data: 2\n\n"))
+	}))
+
+	rr := serve(h, "gzip")
+
+	assert.True(t, rr.Flushed)
+	assert.Equal(t, middleware.EncodingGzip, rr.Header().Get("Content-Encoding"))
+	assert.Equal(t, "data: 1\n\ndata: 2\n\n", decode(t, middleware.EncodingGzip, rr.Body.Bytes()))
+}
+
+func TestCompress_LoadAndSave(t *testing.T) {
+	s := newSessionManager(t)
+	login := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Put(r.Context(), string(middleware.KeyID), uint64(1))
+		_, _ = w.Write([]byte(largeJSON))
+	})
+
+	tests := map[string]http.Handler{
+		"compressing outside": middleware.Compress()(middleware.LoadAndSave(s)(login)),
+		"compressing inside":  middleware.LoadAndSave(s)(middleware.Compress()(login)),
+	}
+
+	for name, h := range tests {
+		t.Run(name, func(t *testing.T) {
+			rr := serve(h, "zstd")
+
+			assert.Len(t, rr.Result().Cookies(), 1, "expected the session cookie")
+			assert.ElementsMatch(t, []string{"Accept-Encoding", "Cookie"}, rr.Header().Values("Vary"))
+			assert.Equal(t, middleware.EncodingZstd, rr.Header().Get("Content-Encoding"))
+			assert.Equal(t, largeJSON, decode(t, middleware.EncodingZstd, rr.Body.Bytes()))
+		})
+	}
+}
+
+func TestPrecompressed(t *testing.T) {
+	var br bytes.Buffer
+	enc := brotli.NewWriter(&br)
+	_, _ = enc.Write([]byte(largeJSON))
+	_ = enc.Close()
+
+	fsys := fstest.MapFS{
+		"app.js":    {Data: []byte(largeJSON)},
+		"app.js.br": {Data: br.Bytes()},
+		"app.css":   {Data: []byte(largeJSON)},
+	}
+	h := middleware.Compress()(middleware.Precompressed(fsys)(http.FileServer(http.FS(fsys))))
+
+	get := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+		return rr
+	}
+
+	t.Run("served as it is", func(t *testing.T) {
+		rr := get("/app.js", "gzip, br")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, middleware.EncodingBrotli, rr.Header().Get("Content-Encoding"))
+		assert.Contains(t, rr.Header().Get("Content-Type"), "javascript")
+		assert.Equal(t, []string{"Accept-Encoding"}, rr.Header().Values("Vary"))
+		assert.Equal(t, br.Bytes(), rr.Body.Bytes())
+	})
+
+	t.Run("compressed on the fly", func(t *testing.T) {
+		rr := get("/app.js", "gzip")
+
+		assert.Equal(t, middleware.EncodingGzip, rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, largeJSON, decode(t, middleware.EncodingGzip, rr.Body.Bytes()))
+	})
+
+	t.Run("not precompressed", func(t *testing.T) {
+		rr := get("/app.css", "br")
+
+		assert.Equal(t, middleware.EncodingBrotli, rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, largeJSON, decode(t, middleware.EncodingBrotli, rr.Body.Bytes()))
+	})
+}
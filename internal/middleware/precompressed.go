@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// Extensions of files compressed ahead of time, by content coding.
+var precompressedExtensions = map[string]string{
+	EncodingBrotli: ".br",
+	EncodingZstd:   ".zst",
+	EncodingGzip:   ".gz",
+}
+
+// Precompressed serves app.js.br, app.js.zst or app.js.gz from fsys in place
+// of app.js when the client accepts its content coding, as it is. Anything
+// else is left to next, usually a file server of the same fsys. Compress
+// leaves these responses alone.
+func Precompressed(fsys fs.FS) func(http.Handler) http.Handler {
+	encodings := []string{EncodingBrotli, EncodingZstd, EncodingGzip}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+			if name == "" || strings.HasSuffix(r.URL.Path, "/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			respond.AddVary(w.Header(), "Accept-Encoding")
+
+			var available []string
+			for _, encoding := range encodings {
+				if _, err := fs.Stat(fsys, name+precompressedExtensions[encoding]); err == nil {
+					available = append(available, encoding)
+				}
+			}
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"), available)
+			if encoding == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			f, err := fsys.Open(name + precompressedExtensions[encoding])
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			defer f.Close()
+
+			content, ok := f.(io.ReadSeeker)
+			stat, err := f.Stat()
+			if !ok || err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Content-Encoding", encoding)
+			// Named after the original file so that its Content-Type is used.
+			http.ServeContent(w, r, name, stat.ModTime(), content)
+		})
+	}
+}
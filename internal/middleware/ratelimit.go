@@ -0,0 +1,263 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// Policy allows Limit requests at once, after which one more is allowed every
+// Period/Limit, so that the whole Limit is available again after a quiet
+// Period. This is GCRA, which behaves like a token bucket holding Limit tokens.
+// A Limit or Period of zero or less disables the policy.
+type Policy struct {
+	// Name keeps the counts of each policy apart, so that a key limited on
+	// one route group is not limited on another.
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+func (p Policy) disabled() bool {
+	return p.Limit <= 0 || p.Period <= 0
+}
+
+// interval is how often one more request is allowed.
+func (p Policy) interval() time.Duration {
+	return p.Period / time.Duration(p.Limit)
+}
+
+// Decision is whether a request may go through, and what to tell the client.
+type Decision struct {
+	Allowed   bool
+	Remaining int
+	// Reset is how long until the whole Limit is available again.
+	Reset time.Duration
+	// RetryAfter is how long until the next request is allowed, when this one
+	// is not.
+	RetryAfter time.Duration
+}
+
+// Limiter counts requests of each key under a policy.
+type Limiter interface {
+	Allow(ctx context.Context, key string, p Policy) (Decision, error)
+}
+
+var errTooManyRequests = errors.New("too many requests")
+
+// KeyFunc returns who a request is counted against, or "" if it does not
+// apply to the request.
+type KeyFunc func(r *http.Request) string
+
+// KeyByIP counts requests by client address, as found by ClientIP.
+func KeyByIP(r *http.Request) string {
+	return "ip:" + readUserIP(r)
+}
+
+// TokenChecker reports whether a bearer token is valid, without recording that
+// it was used. It runs before the limiter has decided, so it only reads.
+type TokenChecker interface {
+	TokenExists(ctx context.Context, token string) (bool, error)
+}
+
+// KeyByToken counts requests by their bearer token, once store has found it
+// to be valid. Any other bearer token does not apply, so that a client cannot
+// get a fresh limit by making up a token for each request. The token itself
+// is not kept. Recording its use is left to Authenticate, once the request is
+// let through.
+func KeyByToken(store TokenChecker) KeyFunc {
+	return func(r *http.Request) string {
+		token, ok := bearerToken(r)
+		if !ok {
+			return ""
+		}
+
+		found, err := store.TokenExists(r.Context(), token)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "rate limiting by token", "error", err)
+			return ""
+		}
+		if !found {
+			return ""
+		}
+
+		sum := sha256.Sum256([]byte(token))
+		return "token:" + hex.EncodeToString(sum[:16])
+	}
+}
+
+// KeyBySessionUser counts requests by the user logged in to the session. It
+// must come after LoadAndSave.
+func KeyBySessionUser(m *scs.SessionManager) KeyFunc {
+	return func(r *http.Request) string {
+		userID, ok := m.Get(r.Context(), string(KeyID)).(uint64)
+		if !ok {
+			return ""
+		}
+		return "user:" + strconv.FormatUint(userID, 10)
+	}
+}
+
+// RateLimit rejects requests over policy with 429 Too Many Requests. Each
+// request is counted against the first key given by keys, and is let through
+// if none applies. Every response carries RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers, and a rejection carries
+// Retry-After. Requests are let through if the limiter fails, so that an
+// unreachable Redis does not take the API down with it. A disabled policy
+// lets every request through without counting it.
+func RateLimit(limiter Limiter, p Policy, keys ...KeyFunc) func(http.Handler) http.Handler {
+	policyHeader := strconv.Itoa(p.Limit) + ";w=" + strconv.Itoa(seconds(p.Period))
+
+	return func(next http.Handler) http.Handler {
+		if p.disabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var key string
+			for _, keyFunc := range keys {
+				if key = keyFunc(r); key != "" {
+					break
+				}
+			}
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			d, err := limiter.Allow(r.Context(), p.Name+":"+key, p)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "rate limiting", "policy", p.Name, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("RateLimit-Policy", policyHeader)
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(p.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+			w.Header().Set("RateLimit-Reset", strconv.Itoa(seconds(d.Reset)))
+
+			if !d.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(seconds(d.RetryAfter)))
+				respond.Error(w, http.StatusTooManyRequests, errTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// seconds rounds d up, so that a client waiting that long is not turned away
+// again.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// gcra decides on a request arriving at now for a key whose theoretical
+// arrival time is tat, returning the new tat to keep when it is allowed.
+func gcra(now, tat time.Time, p Policy) (time.Time, Decision) {
+	interval := p.interval()
+	if tat.Before(now) {
+		tat = now
+	}
+
+	next := tat.Add(interval)
+	allowAt := next.Add(-time.Duration(p.Limit) * interval)
+	if now.Before(allowAt) {
+		return tat, Decision{
+			Reset:      tat.Sub(now),
+			RetryAfter: allowAt.Sub(now),
+		}
+	}
+
+	return next, Decision{
+		Allowed:   true,
+		Remaining: int(now.Sub(allowAt) / interval),
+		Reset:     next.Sub(now),
+	}
+}
+
+// sweepEvery is how often MemoryLimiter forgets keys that are back to their
+// full Limit.
+const sweepEvery = time.Minute
+
+// MemoryLimiter keeps counts in memory. Each instance counts on its own, so
+// use RedisLimiter when running more than one.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	tats      map[string]time.Time
+	maxKeys   int
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type MemoryLimiterOption func(*MemoryLimiter)
+
+// WithMaxKeys caps how many keys are counted at once. When a new key comes
+// along with the cap reached, keys back to their full Limit are forgotten
+// first, then others at random, which lets those clients start afresh.
+// Defaults to 100,000, a few megabytes.
+func WithMaxKeys(n int) MemoryLimiterOption {
+	return func(l *MemoryLimiter) {
+		l.maxKeys = n
+	}
+}
+
+func NewMemoryLimiter(opts ...MemoryLimiterOption) *MemoryLimiter {
+	l := &MemoryLimiter{
+		tats:    make(map[string]time.Time),
+		maxKeys: 100_000,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, p Policy) (Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > sweepEvery {
+		l.sweep(now)
+	}
+	if _, ok := l.tats[key]; !ok && len(l.tats) >= l.maxKeys {
+		l.sweep(now)
+		for k := range l.tats {
+			if len(l.tats) < l.maxKeys {
+				break
+			}
+			delete(l.tats, k)
+		}
+	}
+
+	tat, d := gcra(now, l.tats[key], p)
+	if d.Allowed {
+		l.tats[key] = tat
+	}
+
+	return d, nil
+}
+
+// sweep forgets keys that are back to their full Limit.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	for k, tat := range l.tats {
+		if tat.Before(now) {
+			delete(l.tats, k)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript is gcra run inside Redis, on Redis' clock so that replicas with
+// drifting clocks agree. Times are in milliseconds, which Lua numbers hold
+// exactly.
+//
+// It returns allowed (0 or 1), remaining, reset and retry after.
+var gcraScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local tat = tonumber(redis.call('GET', KEYS[1])) or now
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - limit * interval
+if now < allow_at then
+	return {0, 0, tat - now, allow_at - now}
+end
+
+redis.call('SET', KEYS[1], new_tat, 'PX', new_tat - now)
+return {1, math.floor((now - allow_at) / interval), new_tat - now, 0}
+`)
+
+// RedisLimiter keeps counts in Redis, shared by every instance.
+type RedisLimiter struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func NewRedisLimiter(client redis.UniversalClient) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		prefix: "ratelimit:",
+	}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string, p Policy) (Decision, error) {
+	interval := max(p.interval().Milliseconds(), 1)
+
+	res, err := gcraScript.Run(ctx, l.client, []string{l.prefix + key}, p.Limit, interval).Int64Slice()
+	if err != nil {
+		return Decision{}, fmt.Errorf("running rate limit script: %w", err)
+	}
+	if len(res) != 4 {
+		return Decision{}, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	return Decision{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		Reset:      time.Duration(res[2]) * time.Millisecond,
+		RetryAfter: time.Duration(res[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package middleware_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+)
+
+func limiters(t *testing.T) map[string]middleware.Limiter {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return map[string]middleware.Limiter{
+		"memory": middleware.NewMemoryLimiter(),
+		"redis":  middleware.NewRedisLimiter(client),
+	}
+}
+
+func limited(limiter middleware.Limiter, p middleware.Policy, keys ...middleware.KeyFunc) http.Handler {
+	return middleware.RateLimit(limiter, p, keys...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func request(h http.Handler, remoteAddr, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/author", nil)
+	r.RemoteAddr = remoteAddr
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+	return rr
+}
+
+func TestRateLimit(t *testing.T) {
+	for name, limiter := range limiters(t) {
+		t.Run(name, func(t *testing.T) {
+			h := limited(limiter, middleware.Policy{Name: "api", Limit: 2, Period: time.Minute}, middleware.KeyByIP)
+
+			rr := request(h, "192.0.2.1:1234", "")
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "2", rr.Header().Get("RateLimit-Limit"))
+			assert.Equal(t, "1", rr.Header().Get("RateLimit-Remaining"))
+			assert.Equal(t, "30", rr.Header().Get("RateLimit-Reset"))
+			assert.Equal(t, "2;w=60", rr.Header().Get("RateLimit-Policy"))
+
+			// Another connection from the same address counts the same.
+			rr = request(h, "192.0.2.1:5678", "")
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "0", rr.Header().Get("RateLimit-Remaining"))
+
+			rr = request(h, "192.0.2.1:1234", "")
+			assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+			assert.Equal(t, "0", rr.Header().Get("RateLimit-Remaining"))
+			assert.Equal(t, "30", rr.Header().Get("Retry-After"))
+
+			rr = request(h, "192.0.2.2:1234", "")
+			assert.Equal(t, http.StatusOK, rr.Code, "expected other clients not to be limited")
+		})
+	}
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	for _, p := range []middleware.Policy{
+		{Name: "api", Limit: 0, Period: time.Minute},
+		{Name: "api", Limit: 2, Period: 0},
+	} {
+		h := limited(middleware.NewMemoryLimiter(), p, middleware.KeyByIP)
+
+		for range 3 {
+			rr := request(h, "192.0.2.1:1234", "")
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Empty(t, rr.Header().Get("RateLimit-Limit"))
+		}
+	}
+}
+
+func TestRateLimit_SpoofedIP(t *testing.T) {
+	h := middleware.ClientIP(nil)(limited(middleware.NewMemoryLimiter(),
+		middleware.Policy{Name: "login", Limit: 1, Period: time.Minute}, middleware.KeyByIP))
+
+	for i, spoofed := range []string{"203.0.113.1", "203.0.113.2"} {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		r.Header.Set("X-Forwarded-For", spoofed)
+		r.Header.Set("X-Real-Ip", spoofed)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+
+		if i > 0 {
+			assert.Equal(t, http.StatusTooManyRequests, rr.Code, "expected forwarding headers not to give a new bucket")
+		}
+	}
+}
+
+func TestRateLimit_Refill(t *testing.T) {
+	for name, limiter := range limiters(t) {
+		t.Run(name, func(t *testing.T) {
+			h := limited(limiter, middleware.Policy{Name: "api", Limit: 1, Period: 100 * time.Millisecond}, middleware.KeyByIP)
+
+			assert.Equal(t, http.StatusOK, request(h, "192.0.2.1:1234", "").Code)
+			assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.1:1234", "").Code)
+
+			time.Sleep(150 * time.Millisecond)
+			assert.Equal(t, http.StatusOK, request(h, "192.0.2.1:1234", "").Code)
+		})
+	}
+}
+
+// tokenStore knows of the tokens "one" and "two".
+type tokenStore struct{}
+
+func (tokenStore) TokenExists(_ context.Context, token string) (bool, error) {
+	return token == "one" || token == "two", nil
+}
+
+func TestRateLimit_Keys(t *testing.T) {
+	limiter := middleware.NewMemoryLimiter()
+	p := middleware.Policy{Name: "api", Limit: 1, Period: time.Minute}
+	h := limited(limiter, p, middleware.KeyByToken(tokenStore{}), middleware.KeyByIP)
+
+	// Clients behind the same address are told apart by their token.
+	assert.Equal(t, http.StatusOK, request(h, "192.0.2.1:1234", "one").Code)
+	assert.Equal(t, http.StatusOK, request(h, "192.0.2.1:1234", "two").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.1:1234", "one").Code)
+
+	assert.Equal(t, http.StatusOK, request(h, "192.0.2.1:1234", "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.1:1234", "").Code)
+
+	// Made up tokens are counted by address.
+	assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.1:1234", "made-up").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.1:1234", "made-up-too").Code)
+
+	// Policies count apart.
+	other := limited(limiter, middleware.Policy{Name: "login", Limit: 1, Period: time.Minute}, middleware.KeyByIP)
+	assert.Equal(t, http.StatusOK, request(other, "192.0.2.1:1234", "").Code)
+
+	// Requests no key applies to are let through.
+	unkeyed := limited(limiter, p, middleware.KeyByToken(tokenStore{}))
+	for range 3 {
+		assert.Equal(t, http.StatusOK, request(unkeyed, "192.0.2.1:1234", "").Code)
+	}
+}
+
+func TestMemoryLimiter_MaxKeys(t *testing.T) {
+	limiter := middleware.NewMemoryLimiter(middleware.WithMaxKeys(2))
+	p := middleware.Policy{Name: "api", Limit: 1, Period: time.Minute}
+	h := limited(limiter, p, middleware.KeyByIP)
+
+	for i := range 100 {
+		assert.Equal(t, http.StatusOK, request(h, fmt.Sprintf("192.0.2.%d:1234", i), "").Code)
+	}
+
+	// The latest client is kept, and still limited.
+	assert.Equal(t, http.StatusTooManyRequests, request(h, "192.0.2.99:1234", "").Code)
+	// Forgotten clients start afresh.
+	assert.Equal(t, http.StatusOK, request(h, "192.0.2.0:1234", "").Code)
+}
@@ -1,13 +1,14 @@
 package middleware
 
 import (
-	"encoding/json"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/http/httputil"
 	"runtime/debug"
 
 	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
 )
 
 // Recovery adapted from https://github.com/go-chi/chi/blob/master/middleware/recoverer.go
@@ -20,24 +21,24 @@ func Recovery(next http.Handler) http.Handler {
 				logEntry := middleware.GetLogEntry(r)
 				if logEntry != nil {
 					logEntry.Panic(rvr, debug.Stack())
-				} else {
-					debug.PrintStack()
 				}
 
-				log.Printf("PANIC: %v", rvr)
-				// send to centralised logging system
-				log.Printf("request: %s %s\n", r.Method, r.URL.RequestURI())
-
 				dump, err := httputil.DumpRequest(r, true)
 				if err != nil {
-					log.Println(err)
+					slog.ErrorContext(r.Context(), "dumping panicked request", "error", err)
 				}
 
-				b, _ := json.Marshal(dump)
-				log.Printf("host: %s\n", r.Host)
-				log.Printf("request body: %s\n", b)
-
-				w.WriteHeader(http.StatusInternalServerError)
+				// send to centralised logging system
+				slog.ErrorContext(r.Context(), "panic",
+					"panic", rvr,
+					"method", r.Method,
+					"url", r.URL.RequestURI(),
+					"host", r.Host,
+					"request", string(dump),
+					"stack", string(debug.Stack()),
+				)
+
+				respond.Status(w, http.StatusInternalServerError)
 			}
 		}()
 
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gmhafiz/go8/internal/utility/requestid"
+)
+
+// RequestID identifies each request by its X-Request-ID header, or by a new ID
+// if it has none or an unsafe one. The ID is saved into the request context,
+// where the logger picks it up, set on the current span, and sent back in the
+// X-Request-ID response header, from which respond.Error copies it into error
+// bodies. It must come after Otlp for the span to exist. To read the ID:
+//
+//	id := requestid.FromContext(ctx)
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		w.Header().Set(requestid.Header, id)
+		trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("http.request_id", id))
+
+		next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
+	})
+}
@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/requestid"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/logger"
+)
+
+func TestRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	log := slog.New(logger.NewTraceHandler(&logs, nil)).With("handler", "test")
+
+	h := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.ErrorContext(r.Context(), "failed")
+		respond.Error(w, http.StatusInternalServerError, errors.New("failed"))
+	}))
+
+	t.Run("kept from the client", func(t *testing.T) {
+		logs.Reset()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(requestid.Header, "client-id.1")
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+
+		assert.Equal(t, "client-id.1", rr.Header().Get(requestid.Header))
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		assert.Equal(t, "client-id.1", body["request_id"])
+		assert.Equal(t, respond.ErrUnexpected.Error(), body["detail"], "expected the error to be hidden")
+
+		var record map[string]any
+		assert.NoError(t, json.Unmarshal(logs.Bytes(), &record))
+		assert.Equal(t, "client-id.1", record["requestID"])
+		assert.Equal(t, "test", record["handler"])
+	})
+
+	t.Run("generated when missing", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.NotEmpty(t, rr.Header().Get(requestid.Header))
+	})
+
+	t.Run("replaced when unsafe", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(requestid.Header, "forged\nlevel=INFO")
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+
+		id := rr.Header().Get(requestid.Header)
+		assert.NotEqual(t, "forged\nlevel=INFO", id)
+		assert.True(t, requestid.Valid(id))
+	})
+}
+
+func TestRecovery(t *testing.T) {
+	h := middleware.RequestID(middleware.Recovery(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(requestid.Header, "panicked")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	var body map[string]any
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.Equal(t, "panicked", body["request_id"])
+}
@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+)
+
+const (
+	KeyClient key = "client"
+	// KeyLastSeen is the session key holding when a session was last used, in
+	// unix seconds. It is only kept up to date when there is an idle timeout.
+	KeyLastSeen key = "last_seen"
+
+	// rememberMeKey is where scs.SessionManager.RememberMe keeps its flag.
+	rememberMeKey = "__rememberMe"
+
+	// lastSeenInterval is how stale KeyLastSeen may get before it is updated.
+	// It saves writing a session on every request at the cost of idle timeout
+	// precision.
+	lastSeenInterval = time.Minute
+)
+
+type sessionConfig struct {
+	idleTimeout        time.Duration
+	lifetime           time.Duration
+	rememberMeLifetime time.Duration
+	maxPerUser         int
+}
+
+type SessionOption func(cfg *sessionConfig)
+
+// WithIdleTimeout logs out a session that has not been used for d. Sessions
+// the user asked to be remembered are exempt.
+func WithIdleTimeout(d time.Duration) SessionOption {
+	return func(cfg *sessionConfig) {
+		cfg.idleTimeout = d
+	}
+}
+
+// WithLifetime logs out a session this long after the user logged in,
+// whether it is used or not. rememberMe applies to sessions the user asked to
+// be remembered through scs.SessionManager.RememberMe. Session manager's own
+// Lifetime must be at least as long as both.
+func WithLifetime(lifetime, rememberMe time.Duration) SessionOption {
+	return func(cfg *sessionConfig) {
+		cfg.lifetime = lifetime
+		cfg.rememberMeLifetime = rememberMe
+	}
+}
+
+// WithMaxSessionsPerUser logs out the oldest sessions of a user when logging in
+// once more would exceed n sessions. It needs a session store implementing
+// UserSessionStore.
+func WithMaxSessionsPerUser(n int) SessionOption {
+	return func(cfg *sessionConfig) {
+		cfg.maxPerUser = n
+	}
+}
+
+// expiry returns when a logged-in session runs out, or a zero time when no
+// timeout applies to it.
+func (cfg *sessionConfig) expiry(ctx context.Context, s *scs.SessionManager) time.Time {
+	var expiry time.Time
+	earliest := func(t time.Time) {
+		if expiry.IsZero() || t.Before(expiry) {
+			expiry = t
+		}
+	}
+
+	rememberMe := s.GetBool(ctx, rememberMeKey)
+
+	lifetime := cfg.lifetime
+	if rememberMe {
+		lifetime = cfg.rememberMeLifetime
+	}
+	if authTime := s.GetInt64(ctx, string(KeyAuthTime)); authTime != 0 && lifetime > 0 {
+		earliest(time.Unix(authTime, 0).Add(lifetime))
+	}
+
+	if lastSeen := s.GetInt64(ctx, string(KeyLastSeen)); lastSeen != 0 && cfg.idleTimeout > 0 && !rememberMe {
+		earliest(time.Unix(lastSeen, 0).Add(cfg.idleTimeout))
+	}
+
+	return expiry
+}
+
+func (cfg *sessionConfig) expired(ctx context.Context, s *scs.SessionManager) bool {
+	if !s.Exists(ctx, string(KeyID)) {
+		return false
+	}
+
+	expiry := cfg.expiry(ctx, s)
+	return !expiry.IsZero() && time.Now().After(expiry)
+}
+
+// touch records that a logged-in session is in use, so that the idle timeout
+// starts over.
+func (cfg *sessionConfig) touch(ctx context.Context, s *scs.SessionManager, loggedIn bool) {
+	if cfg.idleTimeout <= 0 || s.Status(ctx) == scs.Destroyed {
+		return
+	}
+
+	lastSeen := s.GetInt64(ctx, string(KeyLastSeen))
+	if loggedIn || time.Since(time.Unix(lastSeen, 0)) >= lastSeenInterval {
+		s.Put(ctx, string(KeyLastSeen), time.Now().Unix())
+	}
+}
+
+// commit saves the session like scs.SessionManager.Commit does, except that
+// a logged-in session expires from the store as soon as its timeouts say so.
+// The session manager only knows a single lifetime for every session.
+func (cfg *sessionConfig) commit(ctx context.Context, s *scs.SessionManager) (string, time.Time, error) {
+	token := s.Token(ctx)
+	expiry := cfg.expiry(ctx, s)
+	if token == "" || expiry.IsZero() || expiry.After(s.Deadline(ctx)) || s.CtxStore == nil {
+		return s.Commit(ctx)
+	}
+
+	values := make(map[string]any)
+	for _, key := range s.Keys(ctx) {
+		values[key] = s.Get(ctx, key)
+	}
+
+	b, err := s.Codec.Encode(s.Deadline(ctx), values)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := s.CtxStore.CommitCtx(ctx, token, b, expiry); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiry, nil
+}
+
+// evict logs out the oldest sessions of a user who has just logged in, other
+// than the current one, to stay within the maximum number of sessions.
+func (cfg *sessionConfig) evict(ctx context.Context, s *scs.SessionManager, userID uint64, currentToken string) error {
+	store, ok := s.CtxStore.(UserSessionStore)
+	if cfg.maxPerUser <= 0 || !ok {
+		return nil
+	}
+
+	sessions, err := store.ListByUserCtx(ctx, userID, currentToken)
+	if err != nil {
+		return err
+	}
+	if len(sessions) <= cfg.maxPerUser {
+		return nil
+	}
+
+	sessions = slices.DeleteFunc(sessions, func(info SessionInfo) bool {
+		return info.Current
+	})
+	slices.SortFunc(sessions, func(a, b SessionInfo) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	// The current session counts towards the maximum.
+	excess := len(sessions) - (cfg.maxPerUser - 1)
+	for _, info := range sessions[:max(excess, 0)] {
+		if _, err := store.DeleteByUserCtx(ctx, userID, info.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Client describes where a request comes from. It is saved into request context
+// by LoadAndSave so that session stores can record it alongside a session.
+type Client struct {
+	IPAddress string
+	UserAgent string
+	Device    string
+}
+
+// SessionInfo is a session as shown to its own user. The token is never
+// exposed; ID is an opaque identifier safe to share with the user.
+type SessionInfo struct {
+	ID         string    `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Expiry     time.Time `json:"expiry"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Device     string    `json:"device"`
+	Current    bool      `json:"current"`
+}
+
+// UserSessionStore is implemented by session stores that track the user ID of
+// each session.
+type UserSessionStore interface {
+	// ListByUserCtx returns active sessions of a user. Session belonging to
+	// currentToken is flagged as current.
+	ListByUserCtx(ctx context.Context, userID uint64, currentToken string) ([]SessionInfo, error)
+	// DeleteByUserCtx deletes a single session by its ID only if it belongs to
+	// the user.
+	DeleteByUserCtx(ctx context.Context, userID uint64, id string) (bool, error)
+	// DeleteAllByUserCtx deletes all sessions of a user except for the one
+	// belonging to exceptToken. It returns number of deleted sessions.
+	DeleteAllByUserCtx(ctx context.Context, userID uint64, exceptToken string) (int64, error)
+}
+
+// SessionToucher is implemented by session stores that record when a session
+// was last seen. It doubles as the existence check in Authenticate.
+type SessionToucher interface {
+	TouchCtx(ctx context.Context, token string) (found bool, err error)
+}
+
+// ClientFromContext returns request origin saved by LoadAndSave.
+func ClientFromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(KeyClient).(Client)
+	return client
+}
+
+func newClient(r *http.Request) Client {
+	return Client{
+		IPAddress: readUserIP(r),
+		UserAgent: r.UserAgent(),
+		Device:    DeviceLabel(r.UserAgent()),
+	}
+}
+
+// DeviceLabel turns a User-Agent header into a short human-readable label such
+// as "Firefox on Linux". It only recognises common browsers and platforms.
+func DeviceLabel(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown device"
+	}
+
+	var browser string
+	switch {
+	case strings.Contains(userAgent, "Edg/"):
+		browser = "Edge"
+	case strings.Contains(userAgent, "OPR/"):
+		browser = "Opera"
+	case strings.Contains(userAgent, "Firefox/"):
+		browser = "Firefox"
+	case strings.Contains(userAgent, "Chrome/"):
+		browser = "Chrome"
+	case strings.Contains(userAgent, "Safari/"):
+		browser = "Safari"
+	default:
+		// Non-browser clients such as curl/8.0.1
+		name, _, _ := strings.Cut(userAgent, "/")
+		return name
+	}
+
+	var platform string
+	switch {
+	case strings.Contains(userAgent, "Android"):
+		platform = "Android"
+	case strings.Contains(userAgent, "iPhone"), strings.Contains(userAgent, "iPad"):
+		platform = "iOS"
+	case strings.Contains(userAgent, "Windows"):
+		platform = "Windows"
+	case strings.Contains(userAgent, "Mac OS X"):
+		platform = "macOS"
+	case strings.Contains(userAgent, "Linux"):
+		platform = "Linux"
+	default:
+		return browser
+	}
+
+	return browser + " on " + platform
+}
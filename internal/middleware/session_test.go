@@ -0,0 +1,240 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gmhafiz/scs/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+	"github.com/gmhafiz/go8/third_party/redisstore"
+)
+
+// newSessionHandler serves `/login`, which logs in the user given by `id`, and
+// `/me`, which responds 401 unless logged in. `/login` takes `remember` to
+// remember the session, and `age` to pretend the user logged in that long ago.
+func newSessionHandler(t *testing.T, opts ...middleware.SessionOption) http.Handler {
+	t.Helper()
+
+	s := newSessionManager(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, _ := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+		age, _ := time.ParseDuration(r.URL.Query().Get("age"))
+
+		if err := s.RenewToken(ctx); err != nil {
+			t.Fatal(err)
+		}
+		s.RememberMe(ctx, r.URL.Query().Get("remember") == "true")
+		s.Put(ctx, string(middleware.KeyID), id)
+		s.Put(ctx, string(middleware.KeyAuthTime), time.Now().Add(-age).Unix())
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		if !s.Exists(r.Context(), string(middleware.KeyID)) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	return middleware.LoadAndSave(s, opts...)(mux)
+}
+
+// newSessionManager keeps sessions in a throwaway Redis.
+func newSessionManager(t *testing.T) *scs.SessionManager {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	hasher, err := tokenhash.New("test-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := redisstore.New(client, hasher)
+	s := scs.New()
+	s.Store = store
+	s.CtxStore = store
+	s.Lifetime = 24 * time.Hour
+	s.Cookie.Persist = false
+
+	return s
+}
+
+func login(t *testing.T, h http.Handler, query string) *http.Cookie {
+	t.Helper()
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/login?"+query, nil))
+
+	cookies := rr.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("got %d cookies: expected a session cookie", len(cookies))
+	}
+	return cookies[0]
+}
+
+func me(h http.Handler, cookie *http.Cookie) int {
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.AddCookie(cookie)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr.Code
+}
+
+func TestLoadAndSave_Lifetime(t *testing.T) {
+	h := newSessionHandler(t, middleware.WithLifetime(time.Hour, 48*time.Hour))
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "within lifetime", query: "id=1&age=30m", want: http.StatusOK},
+		{name: "past lifetime", query: "id=1&age=2h", want: http.StatusUnauthorized},
+		{name: "remembered within lifetime", query: "id=1&age=2h&remember=true", want: http.StatusOK},
+		{name: "remembered past lifetime", query: "id=1&age=49h&remember=true", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cookie := login(t, h, tt.query)
+			if got := me(h, cookie); got != tt.want {
+				t.Errorf("got %d: expected %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadAndSave_IdleTimeout(t *testing.T) {
+	h := newSessionHandler(t, middleware.WithIdleTimeout(time.Second))
+
+	session := login(t, h, "id=1")
+	remembered := login(t, h, "id=1&remember=true")
+
+	if got := me(h, session); got != http.StatusOK {
+		t.Fatalf("got %d: expected an active session to be kept", got)
+	}
+
+	// Last seen is kept in whole seconds.
+	time.Sleep(2100 * time.Millisecond)
+
+	if got := me(h, session); got != http.StatusUnauthorized {
+		t.Errorf("got %d: expected an idle session to be logged out", got)
+	}
+	if got := me(h, remembered); got != http.StatusOK {
+		t.Errorf("got %d: expected a remembered session to be exempt", got)
+	}
+}
+
+func TestLoadAndSave_RememberMeCookie(t *testing.T) {
+	h := newSessionHandler(t)
+
+	if cookie := login(t, h, "id=1"); !cookie.Expires.IsZero() || cookie.MaxAge != 0 {
+		t.Errorf("expected a browser session cookie, got expires %v and max age %d", cookie.Expires, cookie.MaxAge)
+	}
+	if cookie := login(t, h, "id=1&remember=true"); cookie.Expires.IsZero() {
+		t.Errorf("expected a persistent cookie")
+	}
+}
+
+func TestLoadAndSave_MaxSessionsPerUser(t *testing.T) {
+	h := newSessionHandler(t, middleware.WithMaxSessionsPerUser(2))
+
+	var cookies []*http.Cookie
+	for range 3 {
+		cookies = append(cookies, login(t, h, "id=1"))
+		// Sessions are ordered by creation time in milliseconds.
+		time.Sleep(2 * time.Millisecond)
+	}
+	other := login(t, h, "id=2")
+
+	if got := me(h, cookies[0]); got != http.StatusUnauthorized {
+		t.Errorf("got %d: expected the oldest session to be evicted", got)
+	}
+	for i, cookie := range cookies[1:] {
+		if got := me(h, cookie); got != http.StatusOK {
+			t.Errorf("session %d: got %d: expected to be kept", i+1, got)
+		}
+	}
+	if got := me(h, other); got != http.StatusOK {
+		t.Errorf("got %d: expected sessions of other users to be kept", got)
+	}
+}
+
+func TestLoadAndSave_Streaming(t *testing.T) {
+	s := newSessionManager(t)
+	rr := httptest.NewRecorder()
+
+	h := middleware.LoadAndSave(s)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Put(r.Context(), string(middleware.KeyID), uint64(1))
+		_, _ = w.Write([]byte("data: 1\n\n"))
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		// The session went out with the header, before the handler is done.
+		assert.True(t, rr.Flushed)
+		assert.Len(t, rr.Result().Cookies(), 1, "expected the session cookie")
+
+		_, _ = w.Write([]byte("data: 2\n\n"))
+	}))
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, "data: 1\n\ndata: 2\n\n", rr.Body.String())
+}
+
+func TestLoadAndSave_ChangedAfterWrite(t *testing.T) {
+	s := newSessionManager(t)
+
+	h := middleware.LoadAndSave(s)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		s.Put(r.Context(), string(middleware.KeyID), uint64(1))
+	}))
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.Empty(t, rr.Result().Cookies(), "expected the change to be too late to save")
+}
+
+// failingStore fails to save any session.
+type failingStore struct {
+	scs.CtxStore
+}
+
+func (failingStore) CommitCtx(context.Context, string, []byte, time.Time) error {
+	return errors.New("connection refused")
+}
+
+func TestLoadAndSave_CommitFailing(t *testing.T) {
+	s := newSessionManager(t)
+	store := failingStore{CtxStore: s.CtxStore}
+	s.Store = store
+	s.CtxStore = store
+
+	h := middleware.LoadAndSave(s)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Put(r.Context(), string(middleware.KeyID), uint64(1))
+		if _, err := w.Write([]byte("logged in")); err == nil {
+			t.Error("expected writing to fail once the session could not be saved")
+		}
+	}))
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Empty(t, rr.Result().Cookies())
+	assert.NotContains(t, rr.Body.String(), "logged in")
+}
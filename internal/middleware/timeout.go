@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// ErrRequestTimeout is the cause of the cancellation of a request context
+// whose deadline set by Timeout has passed.
+var ErrRequestTimeout = errors.New("the request took too long to complete, please try again later")
+
+type deadlineKey struct{}
+
+// deadline cancels a request when its timer fires. Unlike a context deadline,
+// it can be moved later as well as earlier, or removed.
+type deadline struct {
+	mu     sync.Mutex
+	timer  *time.Timer
+	cancel context.CancelCauseFunc
+}
+
+// reset moves the deadline to d from now, or removes it if d is not positive.
+// A deadline that has passed stays passed.
+func (dl *deadline) reset(d time.Duration) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	if dl.timer != nil && !dl.timer.Stop() {
+		return
+	}
+	dl.timer = nil
+	if d > 0 {
+		dl.timer = time.AfterFunc(d, func() { dl.cancel(ErrRequestTimeout) })
+	}
+}
+
+func (dl *deadline) stop() {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	if dl.timer != nil {
+		dl.timer.Stop()
+	}
+}
+
+// Timeout cancels the request context after d, with ErrRequestTimeout as its
+// cause, so that database queries and other calls made with it are abandoned.
+// If the handler has not started its response by then, whatever it responds
+// with is replaced by 503 Service Unavailable. A response that has started is
+// left alone, because its status has been sent.
+//
+// A Timeout within another replaces the deadline of the outer one, counting
+// from when the inner one is reached. This lets a route take longer, or
+// shorter, than the default set for every route.
+//
+// A d of zero or less removes the deadline, for routes such as streams that
+// run for as long as the client stays. The server's write timeout is lifted
+// for them too, so that the connection is not cut off midway.
+func Timeout(d time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if d <= 0 {
+				// Not every ResponseWriter supports it, such as a recorder in
+				// tests, and there is no deadline to lift then.
+				_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+			}
+
+			if dl, ok := r.Context().Value(deadlineKey{}).(*deadline); ok {
+				dl.reset(d)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithCancelCause(r.Context())
+			defer cancel(nil)
+
+			dl := &deadline{cancel: cancel}
+			dl.reset(d)
+			defer dl.stop()
+
+			tw := &timeoutWriter{ResponseWriter: w, ctx: ctx}
+			next.ServeHTTP(tw, r.WithContext(context.WithValue(ctx, deadlineKey{}, dl)))
+
+			if !tw.wroteHeader && timedOut(ctx) {
+				tw.WriteHeader(http.StatusServiceUnavailable)
+			}
+		})
+	}
+}
+
+func timedOut(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrRequestTimeout)
+}
+
+// timeoutWriter responds with 503 in place of a response started after the
+// deadline, typically the error of a query that was cancelled.
+type timeoutWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	if code < http.StatusOK {
+		tw.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+
+	if timedOut(tw.ctx) {
+		tw.timedOut = true
+		// The problem replaces whatever the handler prepared, so headers
+		// describing that body, such as those set by Compress, no longer apply.
+		tw.Header().Del("Content-Length")
+		tw.Header().Del("Content-Encoding")
+		tw.Header().Del("ETag")
+		respond.Error(tw.ResponseWriter, http.StatusServiceUnavailable, ErrRequestTimeout)
+		return
+	}
+	tw.ResponseWriter.WriteHeader(code)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	if !tw.wroteHeader {
+		tw.WriteHeader(http.StatusOK)
+	}
+	if tw.timedOut {
+		return 0, ErrRequestTimeout
+	}
+	return tw.ResponseWriter.Write(b)
+}
+
+func (tw *timeoutWriter) Flush() {
+	if !tw.wroteHeader {
+		tw.WriteHeader(http.StatusOK)
+	}
+	if tw.timedOut {
+		return
+	}
+	_ = http.NewResponseController(tw.ResponseWriter).Flush()
+}
+
+func (tw *timeoutWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(tw.ResponseWriter).Hijack()
+}
+
+func (tw *timeoutWriter) Unwrap() http.ResponseWriter {
+	return tw.ResponseWriter
+}
+
+func init() {
+	respond.RegisterProblem(ErrRequestTimeout, respond.ProblemType{
+		Type:  "/problems/request-timeout",
+		Title: "Request timeout",
+	})
+}
@@ -0,0 +1,214 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// querying stands for a handler whose query is cancelled with the request,
+// and which responds with its error.
+func querying(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			assert.ErrorIs(t, context.Cause(r.Context()), middleware.ErrRequestTimeout)
+			respond.Error(w, http.StatusInternalServerError, r.Context().Err())
+		case <-time.After(time.Second):
+			t.Error("expected the request context to be cancelled")
+		}
+	})
+}
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{
+			name:    "responding with the error",
+			handler: middleware.Timeout(10 * time.Millisecond)(querying(t)),
+		},
+		{
+			name: "not responding",
+			handler: middleware.Timeout(10 * time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			})),
+		},
+		{
+			name: "shortened by a route",
+			handler: middleware.Timeout(time.Minute)(
+				middleware.Timeout(10 * time.Millisecond)(querying(t)),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+			assert.Equal(t, respond.ContentTypeProblem, rr.Header().Get("Content-Type"))
+
+			var problem map[string]any
+			assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			assert.Equal(t, middleware.ErrRequestTimeout.Error(), problem["detail"])
+		})
+	}
+}
+
+func TestTimeout_InTime(t *testing.T) {
+	h := middleware.Timeout(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("created"))
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "created", rr.Body.String())
+}
+
+func TestTimeout_ExtendedByRoute(t *testing.T) {
+	h := middleware.Timeout(10 * time.Millisecond)(
+		middleware.Timeout(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+				t.Error("expected the deadline of the route to replace the default")
+			case <-time.After(50 * time.Millisecond):
+			}
+			_, _ = w.Write([]byte("exported"))
+		})),
+	)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "exported", rr.Body.String())
+}
+
+func TestTimeout_Started(t *testing.T) {
+	h := middleware.Timeout(10 * time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("data: 1\n\n"))
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		<-r.Context().Done()
+		if _, err := w.Write([]byte("data: 2\n\n")); errors.Is(err, middleware.ErrRequestTimeout) {
+			t.Error("expected a response started in time to be left alone")
+		}
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, rr.Flushed)
+	assert.Equal(t, "data: 1\n\ndata: 2\n\n", rr.Body.String())
+}
+
+func TestTimeout_WrappedError(t *testing.T) {
+	rr := httptest.NewRecorder()
+	respond.Error(rr, http.StatusServiceUnavailable, fmt.Errorf("querying books: %w", middleware.ErrRequestTimeout))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+
+	var problem map[string]any
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, middleware.ErrRequestTimeout.Error(), problem["detail"], "expected only the message of the registered error")
+}
+
+func TestTimeout_Disabled(t *testing.T) {
+	waiting := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			t.Error("expected no deadline")
+		case <-time.After(50 * time.Millisecond):
+		}
+		_, _ = w.Write([]byte("streamed"))
+	})
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{
+			name:    "zero",
+			handler: middleware.Timeout(0)(waiting),
+		},
+		{
+			name:    "by a route",
+			handler: middleware.Timeout(10 * time.Millisecond)(middleware.Timeout(0)(waiting)),
+		},
+		{
+			name: "then set again",
+			handler: middleware.Timeout(10 * time.Millisecond)(
+				middleware.Timeout(0)(middleware.Timeout(time.Minute)(waiting)),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "streamed", rr.Body.String())
+		})
+	}
+}
+
+func TestTimeout_DisabledLiftsWriteTimeout(t *testing.T) {
+	srv := httptest.NewUnstartedServer(middleware.Timeout(0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("streamed"))
+	})))
+	srv.Config.WriteTimeout = 20 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	res, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "streamed", string(body))
+}
+
+func TestTimeout_Compressed(t *testing.T) {
+	late := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		w.Header().Set("ETag", `"v1"`)
+		writing("application/json", largeJSON).ServeHTTP(w, r)
+	})
+	h := middleware.Timeout(10 * time.Millisecond)(middleware.Compress()(late))
+
+	rr := serve(h, "gzip")
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Empty(t, rr.Header().Get("Content-Encoding"), "expected the problem not to be labelled as compressed")
+	assert.Empty(t, rr.Header().Get("ETag"))
+
+	var problem map[string]any
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, middleware.ErrRequestTimeout.Error(), problem["detail"])
+}
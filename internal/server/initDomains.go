@@ -1,12 +1,16 @@
 package server
 
 import (
+	"crypto/ed25519"
 	"embed"
 	"io/fs"
+	"log"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/gmhafiz/go8/internal/domain/admin"
+	"github.com/gmhafiz/go8/internal/domain/audit"
 	"github.com/gmhafiz/go8/internal/domain/authentication"
 	authorHandler "github.com/gmhafiz/go8/internal/domain/author/handler"
 	authorRepo "github.com/gmhafiz/go8/internal/domain/author/repository"
@@ -15,6 +19,8 @@ import (
 	bookRepo "github.com/gmhafiz/go8/internal/domain/book/repository"
 	bookUseCase "github.com/gmhafiz/go8/internal/domain/book/usecase"
 	"github.com/gmhafiz/go8/internal/domain/health"
+	"github.com/gmhafiz/go8/internal/domain/oidc"
+	"github.com/gmhafiz/go8/internal/domain/scim"
 	"github.com/gmhafiz/go8/internal/middleware"
 	"github.com/gmhafiz/go8/internal/utility/respond"
 )
@@ -23,6 +29,10 @@ func (s *Server) InitDomains() {
 	s.initVersion()
 	s.initSwagger()
 	s.initAuthentication()
+	s.initAdmin()
+	s.initAudit()
+	s.initOidc()
+	s.initScim()
 	s.initAuthor()
 	s.initHealth()
 	s.initBook()
@@ -54,7 +64,7 @@ func (s *Server) initSwagger() {
 			panic(err)
 		}
 
-		fileServer := http.FileServer(http.FS(docsPath))
+		fileServer := middleware.Precompressed(docsPath)(http.FileServer(http.FS(docsPath)))
 
 		s.router.HandleFunc("/swagger", func(w http.ResponseWriter, r *http.Request) {
 			http.Redirect(w, r, "/swagger/", http.StatusMovedPermanently)
@@ -87,6 +97,65 @@ func (s *Server) initAuthor() {
 }
 
 func (s *Server) initAuthentication() {
+	opts := []authentication.Option{
+		authentication.WithMailer(s.mailer),
+		authentication.WithNotifier(s.notifications),
+		authentication.WithPasswordHasher(s.passwords),
+		authentication.WithPublicURL(s.cfg.Api.PublicURL),
+		authentication.WithMagicLink(s.cfg.MagicLink),
+		authentication.WithRegistration(s.cfg.Registration),
+	}
+	if s.limiter != nil {
+		opts = append(opts, authentication.WithLoginRateLimit(s.limiter, middleware.Policy{
+			Name:   "login",
+			Limit:  s.cfg.RateLimit.LoginLimit,
+			Period: s.cfg.RateLimit.LoginPeriod,
+		}))
+	}
+
 	repo := authentication.NewRepo(s.ent, s.db, s.session)
-	authentication.RegisterHTTPEndPoints(s.router, s.session, repo)
+	authentication.RegisterHTTPEndPoints(s.router, s.session, repo, opts...)
+}
+
+func (s *Server) initAdmin() {
+	authRepo := authentication.NewRepo(s.ent, s.db, s.session)
+	admin.RegisterHTTPEndPoints(s.router, s.session, admin.NewRepo(s.ent, s.session),
+		middleware.WithTokenStore(authRepo),
+		middleware.WithUserStore(authRepo),
+	)
+}
+
+func (s *Server) initAudit() {
+	var publicKey ed25519.PublicKey
+	if s.checkpoints != nil {
+		publicKey = s.checkpoints.PublicKey()
+	}
+
+	authRepo := authentication.NewRepo(s.ent, s.db, s.session)
+	audit.RegisterHTTPEndPoints(s.router, s.session, audit.NewRepo(s.ent), publicKey,
+		middleware.WithTokenStore(authRepo),
+		middleware.WithUserStore(authRepo),
+	)
+}
+
+func (s *Server) initOidc() {
+	if !s.cfg.Oidc.Enable {
+		return
+	}
+
+	logins := authentication.NewLogins(authentication.NewRepo(s.ent, s.db, s.session), s.notifications)
+	repo := oidc.NewRepo(s.ent)
+	oidc.RegisterHTTPEndPoints(s.router, s.cfg.Oidc, s.session, repo, oidc.WithLoginRecorder(logins))
+}
+
+func (s *Server) initScim() {
+	if !s.cfg.Scim.Enable {
+		return
+	}
+	if len(s.cfg.Scim.Tokens) == 0 {
+		log.Println("SCIM is enabled but SCIM_TOKENS is empty, every request will be rejected")
+	}
+
+	repo := scim.NewRepo(s.ent, s.session)
+	scim.RegisterHTTPEndPoints(s.router, s.cfg.Scim, s.cfg.Api.PublicURL, repo)
 }
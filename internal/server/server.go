@@ -3,7 +3,7 @@ package server
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -18,7 +18,6 @@ import (
 	"syscall"
 	"time"
 
-	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/gmhafiz/scs/v2"
@@ -42,13 +41,30 @@ import (
 	"github.com/gmhafiz/go8/third_party/otlp"
 	//_ "github.com/gmhafiz/go8/docs"
 	"github.com/gmhafiz/go8/ent/gen"
+	"github.com/gmhafiz/go8/internal/domain/audit"
+	"github.com/gmhafiz/go8/internal/domain/authentication"
 	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/respond"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
 	db "github.com/gmhafiz/go8/third_party/database"
+	"github.com/gmhafiz/go8/third_party/mail"
+	"github.com/gmhafiz/go8/third_party/password"
 	"github.com/gmhafiz/go8/third_party/postgresstore"
 	redisLib "github.com/gmhafiz/go8/third_party/redis"
+	"github.com/gmhafiz/go8/third_party/redisstore"
 	"github.com/gmhafiz/go8/third_party/validate"
 )
 
+// notificationQueueSize is how many background emails may wait to be sent
+// before new ones are dropped.
+const notificationQueueSize = 100
+
+// auditQueueSize is how many audit events may wait to be saved before requests
+// making changes have to wait.
+const auditQueueSize = 1000
+
+var errEndpointNotFound = errors.New("endpoint not found")
+
 type Server struct {
 	Version string
 	cfg     *config.Config
@@ -63,6 +79,22 @@ type Server struct {
 	session       *scs.SessionManager
 	sessionCloser *postgresstore.PostgresStore
 
+	mailer mail.Mailer
+	// notifications sends emails nobody waits for, such as new device alerts.
+	notifications *mail.Queue
+	// audit saves changes made through ent during requests.
+	audit *audit.Writer
+	// checkpoints signs the audit log hash chain. It is nil without a signing
+	// key.
+	checkpoints *audit.Checkpointer
+
+	// passwords is shared so that every argon2id call goes through one
+	// bounded hashing pool.
+	passwords *password.Hasher
+
+	// limiter counts requests for rate limiting. It is nil when disabled.
+	limiter middleware.Limiter
+
 	otlp *middleware.Config
 
 	validator *validator.Validate
@@ -109,6 +141,9 @@ func (s *Server) Init() {
 	s.NewDatabase()
 	s.newValidator()
 	s.newAuthentication()
+	s.newMailer()
+	s.newPasswordHasher()
+	s.newRateLimiter()
 	s.newRouter()
 	s.setGlobalMiddleware()
 	s.InitDomains()
@@ -133,7 +168,17 @@ func (s *Server) setCors() {
 				http.MethodPatch,
 				http.MethodDelete,
 			},
-			AllowedHeaders:   []string{"*"},
+			AllowedHeaders: []string{"*"},
+			// So that browsers let scripts quote the request ID, and see how
+			// close they are to being rate limited.
+			ExposedHeaders: []string{
+				"X-Request-ID",
+				"RateLimit-Policy",
+				"RateLimit-Limit",
+				"RateLimit-Remaining",
+				"RateLimit-Reset",
+				"Retry-After",
+			},
 			AllowCredentials: true,
 		})
 }
@@ -176,6 +221,15 @@ func (s *Server) newRedis() {
 	}
 }
 
+func (s *Server) newMailer() {
+	s.mailer = mail.New(s.cfg.Mail)
+	s.notifications = mail.NewQueue(s.mailer, notificationQueueSize)
+}
+
+func (s *Server) newPasswordHasher() {
+	s.passwords = password.New(s.cfg.Password)
+}
+
 func (s *Server) NewDatabase() {
 	if s.cfg.Database.Driver == "" {
 		log.Fatal("please fill in database credentials in .env file or set in environment variable")
@@ -203,42 +257,115 @@ func (s *Server) newValidator() {
 }
 
 func (s *Server) newAuthentication() {
+	// A random secret would log everyone out on restart, and sessions would
+	// not be found by other instances sharing the store.
+	hasher, err := tokenhash.New(s.cfg.Session.Secret...)
+	if err != nil {
+		log.Fatalf("SESSION_SECRET must be set: %v", err)
+	}
+
 	manager := scs.New()
-	manager.Store = postgresstore.New(s.sqlx.DB)
-	manager.CtxStore = postgresstore.New(s.sqlx.DB)
-	manager.Lifetime = s.cfg.Session.Duration
+	switch s.cfg.Session.Store {
+	case "redis":
+		store := redisstore.New(s.redisClient(), hasher)
+		manager.Store = store
+		manager.CtxStore = store
+	default:
+		store := postgresstore.New(s.sqlx.DB, hasher)
+		manager.Store = store
+		manager.CtxStore = store
+		s.sessionCloser = store
+	}
+	// Each session is cut short by LoadAndSave according to whether it is
+	// remembered, so the manager's lifetime is the longest of the two.
+	manager.Lifetime = max(s.cfg.Session.Duration, s.cfg.Session.RememberMeDuration)
 	manager.Cookie.Name = s.cfg.Session.Name
 	manager.Cookie.Domain = s.cfg.Session.Domain
 	manager.Cookie.HttpOnly = s.cfg.Session.HttpOnly
 	manager.Cookie.Path = s.cfg.Session.Path
-	manager.Cookie.Persist = true
+	// Only remembered sessions get a persistent cookie.
+	manager.Cookie.Persist = false
 	manager.Cookie.SameSite = http.SameSite(s.cfg.Session.SameSite)
 	manager.Cookie.Secure = s.cfg.Session.Secure
 
-	s.sessionCloser = postgresstore.NewWithCleanupInterval(s.sqlx.DB, 30*time.Minute)
-
 	s.session = manager
 }
 
+func (s *Server) newRateLimiter() {
+	if !s.cfg.RateLimit.Enable {
+		return
+	}
+
+	switch s.cfg.RateLimit.Store {
+	case "redis":
+		s.limiter = middleware.NewRedisLimiter(s.redisClient())
+	default:
+		s.limiter = middleware.NewMemoryLimiter()
+	}
+}
+
+// redisClient returns the client used for cache, connecting to Redis even if
+// caching is disabled.
+func (s *Server) redisClient() redis.UniversalClient {
+	switch {
+	case s.cluster != nil:
+		return s.cluster
+	case s.cache != nil:
+		return s.cache
+	default:
+		s.cache = redisLib.New(s.cfg.Cache)
+		return s.cache
+	}
+}
+
 func (s *Server) newRouter() {
 	s.router = chi.NewRouter()
 }
 
 func (s *Server) setGlobalMiddleware() {
 	s.router.NotFound(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_, _ = w.Write([]byte(`{"message": "endpoint not found"}`))
+		respond.Error(w, http.StatusNotFound, errEndpointNotFound)
+	})
+	s.router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		respond.Status(w, http.StatusMethodNotAllowed)
 	})
 	s.router.Use(s.cors.Handler)
 	s.router.Use(middleware.Otlp(s.cfg.OpenTelemetry.Enable))
+	s.router.Use(middleware.RequestID)
+	trustedProxies, err := middleware.ParseTrustedProxies(s.cfg.Api.TrustedProxies)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.router.Use(middleware.ClientIP(trustedProxies))
+	if s.cfg.Api.RequestTimeout > 0 {
+		s.router.Use(middleware.Timeout(s.cfg.Api.RequestTimeout))
+	}
+	if s.cfg.Compress.Enable {
+		opts := []middleware.CompressOption{middleware.WithMinSize(s.cfg.Compress.MinSize)}
+		if len(s.cfg.Compress.ContentTypes) > 0 {
+			opts = append(opts, middleware.WithContentTypes(s.cfg.Compress.ContentTypes...))
+		}
+		s.router.Use(middleware.Compress(opts...))
+	}
 	s.router.Use(middleware.Json)
-	s.router.Use(middleware.LoadAndSave(s.session))
+	s.router.Use(middleware.LoadAndSave(s.session,
+		middleware.WithIdleTimeout(s.cfg.Session.IdleTimeout),
+		middleware.WithLifetime(s.cfg.Session.Duration, s.cfg.Session.RememberMeDuration),
+		middleware.WithMaxSessionsPerUser(s.cfg.Session.MaxPerUser),
+	))
 	s.router.Use(middleware.Audit)
 	if s.cfg.Api.RequestLog {
 		s.router.Use(chiMiddleware.Logger)
 	}
 	s.router.Use(middleware.Recovery)
+	if s.limiter != nil {
+		tokens := authentication.NewRepo(s.ent, s.db, s.session)
+		s.router.Use(middleware.RateLimit(s.limiter, middleware.Policy{
+			Name:   "api",
+			Limit:  s.cfg.RateLimit.ApiLimit,
+			Period: s.cfg.RateLimit.ApiPeriod,
+		}, middleware.KeyByToken(tokens), middleware.KeyBySessionUser(s.session), middleware.KeyByIP))
+	}
 }
 
 func (s *Server) Migrate() {
@@ -277,6 +404,15 @@ func (s *Server) Run() {
 		Addr:              s.cfg.Api.Host + ":" + s.cfg.Api.Port,
 		Handler:           s.router,
 		ReadHeaderTimeout: s.cfg.Api.ReadHeaderTimeout,
+		ReadTimeout:       s.cfg.Api.ReadTimeout,
+		WriteTimeout:      s.cfg.Api.WriteTimeout,
+		IdleTimeout:       s.cfg.Api.IdleTimeout,
+	}
+	if s.cfg.Api.WriteTimeout > 0 && s.cfg.Api.WriteTimeout <= s.cfg.Api.RequestTimeout {
+		slog.Warn("API_WRITE_TIMEOUT is not longer than API_REQUEST_TIMEOUT, so slow requests are cut off instead of answered with 503",
+			"writeTimeout", s.cfg.Api.WriteTimeout,
+			"requestTimeout", s.cfg.Api.RequestTimeout,
+		)
 	}
 
 	fmt.Println(`            .,*/(#####(/*,.                               .,*((###(/*.
@@ -358,27 +494,22 @@ func (s *Server) newEnt(dsn string) {
 	drv := entsql.OpenDB(dialect.Postgres, otelDB)
 	client := gen.NewClient(gen.Driver(drv))
 
-	client.Use(func(next ent.Mutator) ent.Mutator {
-		return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
-			meta, ok := ctx.Value(middleware.KeyAuditID).(middleware.Event)
-			if !ok {
-				return next.Mutate(ctx, mutation)
-			}
-
-			val, err := next.Mutate(ctx, mutation)
-
-			meta.Table = mutation.Type()
-			meta.Action = middleware.Action(mutation.Op().String())
-
-			newValues, _ := json.Marshal(val)
-			meta.NewValues = string(newValues)
-			log.Println(meta)
-
-			return val, err
-		})
-	})
+	// Not through client, so that each batch is saved in a transaction of its
+	// own holding the audit chain lock.
+	s.audit = audit.NewWriter(otelDB, auditQueueSize)
+	client.Use(audit.Hook(s.audit))
 
 	s.ent = client
+
+	if s.cfg.Audit.SigningKey == "" {
+		log.Println("AUDIT_SIGNING_KEY is not set, audit log checkpoints are not signed")
+		return
+	}
+	key, err := audit.ParseSigningKey(s.cfg.Audit.SigningKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.checkpoints = audit.NewCheckpointer(client, key, s.cfg.Audit.CheckpointInterval)
 }
 
 // StrPad returns the input string padded on the left, right or both sides using padType to the specified padding length padLength.
@@ -483,10 +614,22 @@ func gracefulShutdown(ctx context.Context, s *Server) error {
 }
 
 func (s *Server) closeResources(ctx context.Context) {
+	if s.notifications != nil {
+		s.notifications.Close()
+	}
+	if s.audit != nil {
+		s.audit.Close()
+	}
+	// After the writer so that the last checkpoint covers everything saved.
+	if s.checkpoints != nil {
+		s.checkpoints.Close()
+	}
 	_ = s.sqlx.Close()
 	_ = s.ent.Close()
 	s.cluster.Shutdown(ctx)
 	s.cache.Shutdown(ctx)
-	s.sessionCloser.StopCleanup()
+	if s.sessionCloser != nil {
+		s.sessionCloser.StopCleanup()
+	}
 	defer s.otlp.Cancel()
 }
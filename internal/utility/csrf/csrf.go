@@ -3,27 +3,22 @@ package csrf
 import (
 	"context"
 	"database/sql"
-	"encoding/hex"
 	"errors"
+	"strings"
 
-	"github.com/cespare/xxhash/v2"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
 )
 
 // ValidToken Checks if CSRF token is valid
-func ValidToken(ctx context.Context, db *sql.DB, token string) bool {
-	hash, err := sum(token)
-	if err != nil {
-		return false
-	}
-
+func ValidToken(ctx context.Context, db *sql.DB, hasher *tokenhash.Hasher, token string) bool {
 	var exists bool
 	row := db.QueryRowContext(ctx, `
 			SELECT EXISTS(
 				SELECT token FROM sessions 
-				WHERE token = $1 
+				WHERE token = ANY(string_to_array($1, ','))
 				  AND current_timestamp < expiry
-			) `, hash)
-	err = row.Scan(&exists)
+			) `, sums(hasher, token))
+	err := row.Scan(&exists)
 	if err != nil {
 		return false
 	}
@@ -32,15 +27,10 @@ func ValidToken(ctx context.Context, db *sql.DB, token string) bool {
 
 // ValidAndDeleteToken deletes the token from the store if and only if token is valid.
 // Useful for one-time token use.
-func ValidAndDeleteToken(ctx context.Context, db *sql.DB, token string) error {
-	hash, err := sum(token)
-	if err != nil {
-		return nil
-	}
-
+func ValidAndDeleteToken(ctx context.Context, db *sql.DB, hasher *tokenhash.Hasher, token string) error {
 	res, err := db.ExecContext(ctx, `
-		DELETE FROM sessions WHERE token = $1 AND current_timestamp < expiry
-	`, hash)
+		DELETE FROM sessions WHERE token = ANY(string_to_array($1, ',')) AND current_timestamp < expiry
+	`, sums(hasher, token))
 	if err != nil {
 		return err
 	}
@@ -56,15 +46,8 @@ func ValidAndDeleteToken(ctx context.Context, db *sql.DB, token string) error {
 	return nil
 }
 
-func sum(token string) (string, error) {
-	h := xxhash.New()
-	_, err := h.Write([]byte(token))
-	if err != nil {
-
-		return "", err
-	}
-	sum := h.Sum(nil)
-	str := hex.EncodeToString(sum)
-
-	return str, err
+// sums returns hashes of token with every session secret, as stored by
+// postgresstore.
+func sums(hasher *tokenhash.Hasher, token string) string {
+	return strings.Join(hasher.Sums(token), ",")
 }
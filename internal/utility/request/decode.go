@@ -0,0 +1,152 @@
+package request
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/gmhafiz/go8/internal/utility/respond"
+)
+
+// ErrUnsupportedMediaType is returned when a request body is in a format
+// that cannot be decoded. It is reported as 415.
+var ErrUnsupportedMediaType = errors.New("body must be application/json, application/msgpack or application/cbor")
+
+var cborDecoding = func() cbor.DecMode {
+	mode, err := cbor.DecOptions{
+		DupMapKey:         cbor.DupMapKeyEnforcedAPF,
+		ExtraReturnErrors: cbor.ExtraDecErrorUnknownField,
+	}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// Decode decodes a request body of the format given by its Content-Type, JSON
+// if there is none, into dst. Field names are the json tags in every format.
+// The body size limit, unknown field and single value checks of decodeJSON
+// apply to each format.
+//
+// Bodies used to be decoded as JSON whatever their Content-Type, and clients
+// such as curl -d send JSON as application/x-www-form-urlencoded. So a body
+// of any other Content-Type is still decoded as JSON, for now, and only one
+// that is not JSON either is ErrUnsupportedMediaType.
+func Decode(w http.ResponseWriter, r *http.Request, dst any) error {
+	mediaType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+	switch {
+	case mediaType == "", mediaType == respond.ContentTypeJSON, strings.HasSuffix(mediaType, "+json"):
+		return decodeJSON(w, r, dst)
+	case mediaType == respond.ContentTypeMsgpack, slices.Contains(respond.MsgpackAliases, mediaType):
+		return decodeMsgpack(w, r, dst)
+	case mediaType == respond.ContentTypeCBOR:
+		return decodeCBOR(w, r, dst)
+	default:
+		err := decodeJSON(w, r, dst)
+		if errors.Is(err, errMalformedJSON) {
+			return ErrUnsupportedMediaType
+		}
+		if err == nil {
+			slog.WarnContext(r.Context(), "JSON body sent with a deprecated Content-Type", "contentType", mediaType)
+		}
+		return err
+	}
+}
+
+func decodeMsgpack(w http.ResponseWriter, r *http.Request, dst any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return decodeError("msgpack", err)
+	}
+
+	dec := newMsgpackDecoder(body)
+	dec.DisallowUnknownFields(true)
+
+	if err := dec.Decode(dst); err != nil {
+		// msgpack has no error type for an unknown field, so the body is
+		// decoded again allowing them. If that succeeds, a key was unknown.
+		if t := reflect.TypeOf(dst); t != nil && t.Kind() == reflect.Pointer {
+			if newMsgpackDecoder(body).Decode(reflect.New(t.Elem()).Interface()) == nil {
+				return errors.New("body contains an unknown key")
+			}
+		}
+		return decodeError("msgpack", err)
+	}
+
+	if _, err := dec.DecodeInterface(); err != io.EOF {
+		return errors.New("body must only contain a single msgpack value")
+	}
+
+	return nil
+}
+
+func newMsgpackDecoder(body []byte) *msgpack.Decoder {
+	dec := msgpack.NewDecoder(bytes.NewReader(body))
+	dec.SetCustomStructTag("json")
+	return dec
+}
+
+func decodeCBOR(w http.ResponseWriter, r *http.Request, dst any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+
+	dec := cborDecoding.NewDecoder(r.Body)
+
+	if err := dec.Decode(dst); err != nil {
+		var unknownFieldError *cbor.UnknownFieldError
+		var unmarshalTypeError *cbor.UnmarshalTypeError
+
+		switch {
+		case errors.As(err, &unknownFieldError):
+			return errors.New("body contains an unknown key")
+		case errors.As(err, &unmarshalTypeError):
+			if unmarshalTypeError.StructFieldName != "" {
+				return fmt.Errorf("body contains incorrect CBOR type for field %q", unmarshalTypeError.StructFieldName)
+			}
+			return errors.New("body contains incorrect CBOR type")
+		}
+		return decodeError("CBOR", err)
+	}
+
+	var extra cbor.RawMessage
+	if err := dec.Decode(&extra); err != io.EOF {
+		return errors.New("body must only contain a single CBOR value")
+	}
+
+	return nil
+}
+
+// decodeError describes an error decoding a body of the given format in the
+// same words as decodeJSON.
+func decodeError(format string, err error) error {
+	var maxBytesError *http.MaxBytesError
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return errors.New("body must not be empty")
+	case errors.As(err, &maxBytesError):
+		return fmt.Errorf("body must not be larger than %d bytes", maxBytes)
+	default:
+		return fmt.Errorf("body contains badly-formed %s", format)
+	}
+}
+
+func init() {
+	respond.RegisterProblem(ErrUnsupportedMediaType, respond.ProblemType{
+		Type:   "/problems/unsupported-media-type",
+		Title:  "Unsupported media type",
+		Status: http.StatusUnsupportedMediaType,
+	})
+}
@@ -9,11 +9,16 @@ import (
 	"strings"
 )
 
-// DecodeJSON is a generic decoder with safety built-in. Copied from
+// maxBytes is the largest request body decoded, in any format.
+const maxBytes = 1_048_576
+
+// errMalformedJSON is returned for a body that is not JSON at all.
+var errMalformedJSON = errors.New("body contains badly-formed JSON")
+
+// decodeJSON is a generic decoder with safety built-in. Copied from
 // https://www.alexedwards.net/blog/how-to-properly-parse-a-json-request-body
-func DecodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
-	maxBytes := 1_048_576
-	r.Body = http.MaxBytesReader(w, r.Body, int64(maxBytes))
+func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
 
 	dec := json.NewDecoder(r.Body)
 	dec.DisallowUnknownFields()
@@ -26,10 +31,10 @@ func DecodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
 
 		switch {
 		case errors.As(err, &syntaxError):
-			return fmt.Errorf("body contains badly-formed JSON (at character %d)", syntaxError.Offset)
+			return fmt.Errorf("%w (at character %d)", errMalformedJSON, syntaxError.Offset)
 
 		case errors.Is(err, io.ErrUnexpectedEOF):
-			return errors.New("body contains badly-formed JSON")
+			return errMalformedJSON
 
 		case errors.As(err, &unmarshalTypeError):
 			if unmarshalTypeError.Field != "" {
@@ -0,0 +1,50 @@
+// Package requestid identifies a request across logs, traces and responses so
+// that an error reported by a user can be found in the logs.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header carries the ID of a request, both from the client and back to it.
+const Header = "X-Request-ID"
+
+// maxLength is the longest ID accepted from a client.
+const maxLength = 128
+
+type key struct{}
+
+// New returns a random ID.
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether an ID given by a client may be used as is. Only short
+// IDs of letters, digits and `-_.:` are, so that they cannot forge log lines.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, key{}, id)
+}
+
+// FromContext returns the ID saved by NewContext, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(key{}).(string)
+	return id
+}
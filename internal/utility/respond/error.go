@@ -1,58 +1,36 @@
 package respond
 
 import (
-	"encoding/json"
 	"log"
+	"log/slog"
 	"net/http"
-)
-
-func Errors(w http.ResponseWriter, statusCode int, errors []string) {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(statusCode)
 
-	if errors == nil {
-		write(w, nil)
-		return
-	}
-
-	p := map[string][]string{
-		"message": errors,
-	}
-	data, err := json.Marshal(p)
-	if err != nil {
-		log.Println(err)
-	}
+	"github.com/gmhafiz/go8/internal/utility/requestid"
+)
 
-	if string(data) == "null" {
-		return
+// Errors responds with a problem listing what is wrong with a request, under
+// the errors member.
+func Errors[E any](w http.ResponseWriter, statusCode int, errors []E) {
+	p := NewProblem(statusCode, nil)
+	if errors != nil {
+		p.Detail = "The request is invalid."
+		p.Extensions = map[string]any{"errors": errors}
 	}
-
-	write(w, data)
+	p.Write(w)
 }
 
-func Error(w http.ResponseWriter, statusCode int, message error) {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(statusCode)
-
-	var p map[string]string
-	if message == nil {
-		write(w, nil)
-		return
-	}
-
-	p = map[string]string{
-		"message": message.Error(),
-	}
-	data, err := json.Marshal(p)
-	if err != nil {
-		log.Println(err)
-	}
-
-	if string(data) == "null" {
-		return
-	}
-
-	write(w, data)
+// Error responds with err as a problem, see NewProblem. Server errors that are
+// hidden from the client, in whole or in part, are logged.
+func Error(w http.ResponseWriter, statusCode int, err error) {
+	p := NewProblem(statusCode, err)
+	if err != nil && p.Status >= http.StatusInternalServerError && p.Detail != err.Error() {
+		slog.Error("unexpected error",
+			"requestID", w.Header().Get(requestid.Header),
+			"status", statusCode,
+			"error", err,
+		)
+	}
+	p.Write(w)
 }
 
 func write(w http.ResponseWriter, data []byte) {
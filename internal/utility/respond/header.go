@@ -0,0 +1,19 @@
+package respond
+
+import (
+	"net/http"
+	"strings"
+)
+
+// AddVary adds a field name to the Vary header unless it is there already.
+func AddVary(h http.Header, field string) {
+	for _, value := range h.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "*" || strings.EqualFold(name, field) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", field)
+}
@@ -0,0 +1,156 @@
+package respond
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"maps"
+	"net/http"
+	"sync"
+
+	"github.com/gmhafiz/go8/internal/utility/message"
+	"github.com/gmhafiz/go8/internal/utility/requestid"
+)
+
+// ContentTypeProblem is the media type of Problem.
+const ContentTypeProblem = "application/problem+json"
+
+// ErrUnexpected stands in for errors that are not meant for clients.
+var ErrUnexpected = errors.New("An unexpected error occurred. Please quote the request ID when reporting it.")
+
+// Problem is an RFC 9457 problem details object.
+type Problem struct {
+	// Type is a URI identifying the kind of problem. "about:blank" means there
+	// is nothing more to it than the status code.
+	Type string
+	// Title is a short summary of the kind of problem, the same for every
+	// occurrence.
+	Title string
+	// Status is the HTTP status code.
+	Status int
+	// Detail explains this occurrence of the problem.
+	Detail string
+	// Instance is a URI identifying this occurrence of the problem.
+	Instance string
+	// Extensions are extra members, such as errors of each field. The request
+	// ID is added as request_id when written.
+	Extensions map[string]any
+}
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	members := make(map[string]any, len(p.Extensions)+5)
+	maps.Copy(members, p.Extensions)
+
+	members["type"] = p.Type
+	members["title"] = p.Title
+	members["status"] = p.Status
+	if p.Detail != "" {
+		members["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		members["instance"] = p.Instance
+	}
+
+	return json.Marshal(members)
+}
+
+// Write sends p with its status code.
+func (p *Problem) Write(w http.ResponseWriter) {
+	if id := w.Header().Get(requestid.Header); id != "" {
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]any, 1)
+		}
+		p.Extensions["request_id"] = id
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		slog.Error("encoding problem", "error", err)
+		w.WriteHeader(p.Status)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.WriteHeader(p.Status)
+	write(w, data)
+}
+
+// ProblemType is a kind of problem that a domain error is reported as.
+type ProblemType struct {
+	// Type is a URI identifying the kind of problem.
+	Type  string
+	Title string
+	// Status replaces the status code given to Error if set.
+	Status int
+}
+
+var problemTypes struct {
+	sync.RWMutex
+	errs  []error
+	types []ProblemType
+}
+
+// RegisterProblem reports err, and errors wrapping it, as t. The message of
+// err is the detail, so it must be fit for clients. Whatever errors wrapping
+// it add to the message is left out.
+func RegisterProblem(err error, t ProblemType) {
+	problemTypes.Lock()
+	defer problemTypes.Unlock()
+
+	problemTypes.errs = append(problemTypes.errs, err)
+	problemTypes.types = append(problemTypes.types, t)
+}
+
+// problemType returns the kind of problem err is, along with the registered
+// error it wraps.
+func problemType(err error) (ProblemType, error, bool) {
+	problemTypes.RLock()
+	defer problemTypes.RUnlock()
+
+	for i, target := range problemTypes.errs {
+		if errors.Is(err, target) {
+			return problemTypes.types[i], target, true
+		}
+	}
+	return ProblemType{}, nil, false
+}
+
+// NewProblem describes err as a problem of the given status. A registered err
+// takes its type, title and status from the registry, and the message of the
+// registered error as the detail. Otherwise, the message
+// of err is the detail, unless the status is 500 or above. Those errors are
+// unexpected and may carry internals such as database messages, so they are
+// logged with the request ID instead, and the client is asked to quote it.
+func NewProblem(statusCode int, err error) *Problem {
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+	}
+
+	if t, target, ok := problemType(err); ok {
+		p.Type = t.Type
+		p.Title = t.Title
+		if t.Status != 0 {
+			p.Status = t.Status
+		}
+		p.Detail = target.Error()
+		return p
+	}
+
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		p.Detail = ErrUnexpected.Error()
+	case err != nil:
+		p.Detail = err.Error()
+	}
+
+	return p
+}
+
+func init() {
+	RegisterProblem(message.ErrNoRecord, ProblemType{
+		Type:  "/problems/not-found",
+		Title: "Record not found",
+	})
+}
@@ -0,0 +1,301 @@
+package respond
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Media types that Render can respond with.
+const (
+	ContentTypeJSON    = "application/json"
+	ContentTypeMsgpack = "application/msgpack"
+	ContentTypeCBOR    = "application/cbor"
+	ContentTypeCSV     = "text/csv"
+)
+
+// MsgpackAliases are media types that clients use for msgpack other than
+// ContentTypeMsgpack.
+var MsgpackAliases = []string{"application/x-msgpack", "application/vnd.msgpack"}
+
+// ErrNotAcceptable is returned when none of the media types in Accept can be
+// responded with.
+var ErrNotAcceptable = errors.New("none of the media types in Accept can be produced: use application/json, application/msgpack or application/cbor, or text/csv for lists")
+
+var cborEncoding = func() cbor.EncMode {
+	mode, err := cbor.EncOptions{
+		Time:    cbor.TimeRFC3339Nano,
+		TimeTag: cbor.EncTagRequired,
+	}.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// Render responds with payload in the media type the client prefers in its
+// Accept header, JSON if it has no preference. Field names are the json tags
+// in every format. CSV is only offered for lists, a slice of structs or a
+// Standard holding one, and carries the rows of Data without Meta. A client
+// accepting none of them is answered with 406.
+func Render(w http.ResponseWriter, r *http.Request, statusCode int, payload any) {
+	AddVary(w.Header(), "Accept")
+
+	offers := []string{ContentTypeJSON, ContentTypeMsgpack, ContentTypeCBOR}
+	if tabular(payload) {
+		offers = append(offers, ContentTypeCSV)
+	}
+
+	switch negotiateContentType(r.Header.Get("Accept"), offers) {
+	case ContentTypeJSON:
+		Json(w, statusCode, payload)
+	case ContentTypeMsgpack:
+		render(w, statusCode, ContentTypeMsgpack, payload, encodeMsgpack)
+	case ContentTypeCBOR:
+		render(w, statusCode, ContentTypeCBOR, payload, cborEncoding.Marshal)
+	case ContentTypeCSV:
+		render(w, statusCode, ContentTypeCSV+"; charset=utf-8", payload, encodeCSV)
+	default:
+		Error(w, http.StatusNotAcceptable, ErrNotAcceptable)
+	}
+}
+
+// render encodes payload before sending the header so that a failure can
+// still be responded with 500.
+func render(w http.ResponseWriter, statusCode int, contentType string, payload any, encode func(any) ([]byte, error)) {
+	data, err := encode(payload)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, fmt.Errorf("encoding %s: %w", contentType, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	write(w, data)
+}
+
+func encodeMsgpack(payload any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	if err := enc.Encode(payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// negotiateContentType returns the offer given the highest weight by an
+// Accept header, the earliest offer on a tie, or "" for none. A media range
+// that is more specific wins over a broader one, so that `*/*;q=0.1,
+// text/csv` weighs text/csv at 1.
+func negotiateContentType(accept string, offers []string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	type mediaRange struct {
+		typ, subtype string
+		q            float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		typ, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(mediaType)), "/")
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				var err error
+				if q, err = strconv.ParseFloat(value, 64); err != nil {
+					q = 0
+				}
+			}
+		}
+		ranges = append(ranges, mediaRange{typ: typ, subtype: subtype, q: q})
+	}
+
+	var best string
+	var bestWeight float64
+	for _, offer := range offers {
+		names := []string{offer}
+		if offer == ContentTypeMsgpack {
+			names = append(names, MsgpackAliases...)
+		}
+
+		q, specificity := 0.0, -1
+		for _, mr := range ranges {
+			for _, name := range names {
+				typ, subtype, _ := strings.Cut(name, "/")
+				var s int
+				switch {
+				case mr.typ == typ && mr.subtype == subtype:
+					s = 2
+				case mr.typ == typ && mr.subtype == "*":
+					s = 1
+				case mr.typ == "*" && mr.subtype == "*":
+					s = 0
+				default:
+					continue
+				}
+				if s > specificity {
+					q, specificity = mr.q, s
+				}
+			}
+		}
+
+		if q > bestWeight {
+			best, bestWeight = offer, q
+		}
+	}
+	return best
+}
+
+// rows returns the list payload holds, if any.
+func rows(payload any) (reflect.Value, bool) {
+	if standard, ok := payload.(Standard); ok {
+		payload = standard.Data
+	} else if standard, ok := payload.(*Standard); ok && standard != nil {
+		payload = standard.Data
+	}
+
+	v := reflect.ValueOf(payload)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return reflect.Value{}, false
+	}
+
+	elem := v.Type().Elem()
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	return v, elem.Kind() == reflect.Struct
+}
+
+func tabular(payload any) bool {
+	_, ok := rows(payload)
+	return ok
+}
+
+// column is an exported struct field and the name its json tag gives it.
+type column struct {
+	name  string
+	index int
+}
+
+func columns(t reflect.Type) []column {
+	var cols []column
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		cols = append(cols, column{name: name, index: i})
+	}
+	return cols
+}
+
+// encodeCSV writes a header row of column names, then a row for each element.
+// Fields that are not scalars, such as nested lists, are written as JSON.
+func encodeCSV(payload any) ([]byte, error) {
+	list, _ := rows(payload)
+
+	elem := list.Type().Elem()
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	cols := columns(elem)
+
+	var buf bytes.Buffer
+	wr := csv.NewWriter(&buf)
+
+	record := make([]string, len(cols))
+	for i, col := range cols {
+		record[i] = col.name
+	}
+	if err := wr.Write(record); err != nil {
+		return nil, err
+	}
+
+	for i := range list.Len() {
+		row := list.Index(i)
+		for row.Kind() == reflect.Pointer && !row.IsNil() {
+			row = row.Elem()
+		}
+		if row.Kind() == reflect.Pointer {
+			continue
+		}
+
+		for j, col := range cols {
+			cell, err := formatCell(row.Field(col.index))
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", col.name, err)
+			}
+			record[j] = cell
+		}
+		if err := wr.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	wr.Flush()
+	return buf.Bytes(), wr.Error()
+}
+
+func formatCell(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339Nano), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil() {
+		return "", nil
+	}
+	b, err := json.Marshal(v.Interface())
+	return string(b), err
+}
+
+func init() {
+	RegisterProblem(ErrNotAcceptable, ProblemType{
+		Type:  "/problems/not-acceptable",
+		Title: "Not acceptable",
+	})
+}
@@ -2,6 +2,12 @@ package respond
 
 import "net/http"
 
+// Status responds with only a status code, or with a problem of that status
+// if it is an error.
 func Status(w http.ResponseWriter, statusCode int) {
+	if statusCode >= http.StatusBadRequest {
+		NewProblem(statusCode, nil).Write(w)
+		return
+	}
 	w.WriteHeader(statusCode)
 }
@@ -21,7 +21,7 @@ func Parse(date string, format ...string) time.Time {
 }
 
 func parseISO8601(iso8601 string) time.Time {
-	timeWant, err := time.Parse("2006-01-02T15:04:05", iso8601)
+	timeWant, err := time.Parse(time.DateOnly, iso8601)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -0,0 +1,62 @@
+// Package tokenhash hashes session and CSRF tokens with HMAC-SHA256 before they
+// are stored, so that a leaked sessions table cannot be used to log in.
+//
+// Several secrets can be used at once to allow rotation. New tokens are always
+// hashed with the first secret while lookups try every secret:
+//
+//  1. Prepend a new secret: SESSION_SECRET=new,old
+//  2. Wait for sessions hashed with the old secret to be used again, which
+//     re-hashes them with the new secret, or to expire.
+//  3. Remove the old secret: SESSION_SECRET=new
+package tokenhash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+var ErrNoSecret = errors.New("at least one secret is required")
+
+type Hasher struct {
+	keys [][]byte
+}
+
+// New returns a Hasher using secrets ordered from newest to oldest. Empty
+// secrets are ignored.
+func New(secrets ...string) (*Hasher, error) {
+	h := &Hasher{}
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		h.keys = append(h.keys, []byte(secret))
+	}
+
+	if len(h.keys) == 0 {
+		return nil, ErrNoSecret
+	}
+
+	return h, nil
+}
+
+// Sum hashes token with the newest secret. Use it to store a token.
+func (h *Hasher) Sum(token string) string {
+	return sum(h.keys[0], token)
+}
+
+// Sums hashes token with every secret, newest first. Use it to look up a token.
+func (h *Hasher) Sums(token string) []string {
+	sums := make([]string, 0, len(h.keys))
+	for _, key := range h.keys {
+		sums = append(sums, sum(key, token))
+	}
+	return sums
+}
+
+func sum(key []byte, token string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -3,12 +3,34 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
+
+	"github.com/gmhafiz/go8/third_party/validate"
 )
 
-func Validate(v *validator.Validate, generic any) []string {
-	err := v.Struct(generic)
+// FieldError is what is wrong with one field of a request.
+type FieldError struct {
+	// Field is the name of the field in its json tag.
+	Field string `json:"field"`
+	// JSONPointer locates the field in the request body, such as
+	// /books/0/title.
+	JSONPointer string `json:"json_pointer"`
+	// Rule is the validate tag the field failed, such as required.
+	Rule string `json:"rule"`
+	// Param is the parameter of the rule, such as 13 for min=13.
+	Param string `json:"param"`
+	// Message explains the error in the language asked for by the
+	// Accept-Language header of r.
+	Message string `json:"message"`
+}
+
+// Validate checks generic against its validate tags. Fields are named by
+// their json tags when v is made by validate.New.
+func Validate(v *validator.Validate, r *http.Request, generic any) []FieldError {
+	err := v.StructCtx(r.Context(), generic)
 	if err != nil {
 		// this check is only needed when your code could produce
 		// an invalid value for validation such as interface with nil
@@ -19,12 +41,48 @@ func Validate(v *validator.Validate, generic any) []string {
 			return nil
 		}
 
-		var errs []string
+		trans := validate.Translator(r.Header.Get("Accept-Language"))
+
+		var errs []FieldError
 		for _, err := range err.(validator.ValidationErrors) {
-			errs = append(errs, fmt.Sprintf("%s is %s with type %s", err.StructField(), err.Tag(), err.Type()))
+			errs = append(errs, FieldError{
+				Field:       err.Field(),
+				JSONPointer: jsonPointer(err.Namespace()),
+				Rule:        err.Tag(),
+				Param:       err.Param(),
+				Message:     err.Translate(trans),
+			})
 		}
 
 		return errs
 	}
 	return nil
 }
+
+// jsonPointer turns a namespace such as CreateRequest.books[0].title into
+// /books/0/title.
+func jsonPointer(namespace string) string {
+	// The first part is the name of the struct being validated.
+	_, path, found := strings.Cut(namespace, ".")
+	if !found {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, part := range strings.Split(path, ".") {
+		name, index, _ := strings.Cut(part, "[")
+		b.WriteString("/" + escape(name))
+		for index != "" {
+			var key string
+			key, index, _ = strings.Cut(index, "]")
+			b.WriteString("/" + escape(key))
+			index = strings.TrimPrefix(index, "[")
+		}
+	}
+	return b.String()
+}
+
+// escape escapes a reference token of a JSON pointer, RFC 6901.
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
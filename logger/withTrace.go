@@ -6,10 +6,13 @@ import (
 	"log/slog"
 
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gmhafiz/go8/internal/utility/requestid"
 )
 
 const keyTraceID = "traceID"
 const keySpanID = "spanID"
+const keyRequestID = "requestID"
 
 type WithTraceID struct {
 	h slog.Handler
@@ -43,6 +46,9 @@ func (t *WithTraceID) Handle(ctx context.Context, r slog.Record) error {
 			},
 		)
 	}
+	if id := requestid.FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String(keyRequestID, id))
+	}
 	if r.Message != "" {
 		trace.SpanFromContext(ctx).AddEvent(r.Message)
 	}
@@ -51,9 +57,9 @@ func (t *WithTraceID) Handle(ctx context.Context, r slog.Record) error {
 }
 
 func (t *WithTraceID) WithAttrs(attrs []slog.Attr) slog.Handler {
-	return t.h.WithAttrs(attrs)
+	return &WithTraceID{h: t.h.WithAttrs(attrs)}
 }
 
 func (t *WithTraceID) WithGroup(name string) slog.Handler {
-	return t.h.WithGroup(name)
+	return &WithTraceID{h: t.h.WithGroup(name)}
 }
@@ -0,0 +1,65 @@
+// Package mail sends transactional emails such as email verification links.
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns a Mailer based on configured driver. Unknown driver falls back to
+// logging emails, which is handy for local development.
+func New(cfg config.Mail) Mailer {
+	switch cfg.Driver {
+	case "smtp":
+		return &SMTP{cfg: cfg}
+	default:
+		return &Log{}
+	}
+}
+
+// Log prints emails instead of sending them.
+type Log struct{}
+
+func (l *Log) Send(ctx context.Context, msg Message) error {
+	slog.InfoContext(ctx, "mail", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
+
+type SMTP struct {
+	cfg config.Mail
+}
+
+func (s *SMTP) Send(_ context.Context, msg Message) error {
+	var auth smtp.Auth
+	if s.cfg.User != "" {
+		auth = smtp.PlainAuth("", s.cfg.User, s.cfg.Pass, s.cfg.Host)
+	}
+
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "From: %s\r\n", s.cfg.From)
+	_, _ = fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	_, _ = fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	addr := net.JoinHostPort(s.cfg.Host, s.cfg.Port)
+
+	return smtp.SendMail(addr, auth, s.cfg.From, []string{msg.To}, []byte(b.String()))
+}
@@ -0,0 +1,76 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+var ErrQueueFull = errors.New("mail queue is full")
+
+// sendTimeout bounds each queued email so that a stuck mail server does not
+// hold up the rest of the queue.
+const sendTimeout = 30 * time.Second
+
+type queued struct {
+	ctx context.Context
+	msg Message
+}
+
+// Queue sends emails in the background so that a request does not wait for
+// the mail server. It is meant for notifications whose delivery the caller
+// does not need to confirm. Emails still queued are lost if the process dies.
+type Queue struct {
+	mailer   Mailer
+	messages chan queued
+	wg       sync.WaitGroup
+	close    sync.Once
+}
+
+// NewQueue starts a worker sending through mailer, with room for size emails
+// waiting to be sent.
+func NewQueue(mailer Mailer, size int) *Queue {
+	q := &Queue{
+		mailer:   mailer,
+		messages: make(chan queued, size),
+	}
+
+	q.wg.Add(1)
+	go q.work()
+
+	return q
+}
+
+// Send queues msg without blocking. It returns ErrQueueFull when there is no
+// room.
+func (q *Queue) Send(ctx context.Context, msg Message) error {
+	select {
+	case q.messages <- queued{ctx: context.WithoutCancel(ctx), msg: msg}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close waits for queued emails to be sent. Send must not be called after
+// Close.
+func (q *Queue) Close() {
+	q.close.Do(func() {
+		close(q.messages)
+	})
+	q.wg.Wait()
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for m := range q.messages {
+		ctx, cancel := context.WithTimeout(m.ctx, sendTimeout)
+		if err := q.mailer.Send(ctx, m.msg); err != nil {
+			slog.ErrorContext(ctx, "sending queued mail", "to", m.msg.To, "subject", m.msg.Subject, "error", err)
+		}
+		cancel()
+	}
+}
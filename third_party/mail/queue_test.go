@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingMailer struct {
+	release chan struct{}
+	mu      sync.Mutex
+	sent    []Message
+}
+
+func (b *blockingMailer) Send(_ context.Context, msg Message) error {
+	<-b.release
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent = append(b.sent, msg)
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	mailer := &blockingMailer{release: make(chan struct{})}
+	q := NewQueue(mailer, 1)
+
+	// Canceling the request must not cancel sending.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The worker takes the first email and blocks, the second fills the
+	// queue.
+	assert.Nil(t, q.Send(ctx, Message{To: "a@example.com"}))
+	assert.Eventually(t, func() bool { return len(q.messages) == 0 }, time.Second, time.Millisecond)
+	assert.Nil(t, q.Send(ctx, Message{To: "b@example.com"}))
+	assert.ErrorIs(t, q.Send(ctx, Message{To: "c@example.com"}), ErrQueueFull)
+
+	close(mailer.release)
+	q.Close()
+
+	assert.Equal(t, []Message{{To: "a@example.com"}, {To: "b@example.com"}}, mailer.sent)
+}
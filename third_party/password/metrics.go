@@ -0,0 +1,75 @@
+package password
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/gmhafiz/go8/third_party/password"
+
+// metrics records how long calls wait for a worker and how many are turned
+// away. Pool utilisation is observed from channel lengths on each collection.
+type metrics struct {
+	wait   metric.Float64Histogram
+	reject metric.Int64Counter
+}
+
+func newMetrics(h *Hasher) *metrics {
+	meter := otel.Meter(meterName)
+
+	wait, err := meter.Float64Histogram("password.hash.queue.wait",
+		metric.WithDescription("Time spent waiting for a password hashing worker."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		slog.Warn("password: creating queue wait histogram", "err", err)
+	}
+
+	reject, err := meter.Int64Counter("password.hash.rejected",
+		metric.WithDescription("Password hashing calls rejected because the queue was full."),
+	)
+	if err != nil {
+		slog.Warn("password: creating rejected counter", "err", err)
+	}
+
+	_, err = meter.Float64ObservableGauge("password.hash.utilization",
+		metric.WithDescription("Fraction of password hashing workers in use."),
+		metric.WithUnit("1"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			o.Observe(float64(len(h.workers)) / float64(cap(h.workers)))
+			return nil
+		}),
+	)
+	if err != nil {
+		slog.Warn("password: creating utilization gauge", "err", err)
+	}
+
+	_, err = meter.Int64ObservableGauge("password.hash.queue.length",
+		metric.WithDescription("Password hashing calls waiting for a worker."),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(max(len(h.slots)-len(h.workers), 0)))
+			return nil
+		}),
+	)
+	if err != nil {
+		slog.Warn("password: creating queue length gauge", "err", err)
+	}
+
+	return &metrics{wait: wait, reject: reject}
+}
+
+func (m *metrics) waited(ctx context.Context, d time.Duration) {
+	if m.wait != nil {
+		m.wait.Record(ctx, d.Seconds())
+	}
+}
+
+func (m *metrics) rejected(ctx context.Context) {
+	if m.reject != nil {
+		m.reject.Add(ctx, 1)
+	}
+}
@@ -0,0 +1,263 @@
+// Package password hashes passwords with argon2id and verifies hashes imported
+// from older systems.
+//
+// Supported hash formats:
+//
+//	$argon2id$v=19$m=65536,t=1,p=2$<salt>$<key>          (argon2id)
+//	$2a$10$<salt and key>                                (bcrypt, also $2b$ and $2y$)
+//	pbkdf2_sha256$<iterations>$<salt>$<base64 key>       (Django, also pbkdf2_sha1)
+//	$pbkdf2-sha256$<iterations>$<base64 salt>$<base64 key> (passlib, also sha1 and sha512)
+//
+// Anything other than argon2id made with current parameters is reported as
+// needing a rehash, so that it can be upgraded once plain text password is known
+// after a successful login.
+//
+// Hashing and verifying are memory and CPU heavy, so both run through a bounded
+// pool shared by the whole process. Calls beyond the number of workers wait in
+// a queue, and ErrBusy is returned when the queue is full.
+package password
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"hash"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown password hash format")
+	ErrBusy          = errors.New("password hashing is busy, try again later")
+)
+
+// RetryAfter is a suggested wait before retrying after ErrBusy.
+const RetryAfter = time.Second
+
+type Hasher struct {
+	params *argon2id.Params
+
+	// workers holds a token for each hash in progress.
+	workers chan struct{}
+	// slots holds a token for each hash in progress or waiting for a worker.
+	slots chan struct{}
+
+	metrics *metrics
+}
+
+func New(cfg config.Password) *Hasher {
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	queue := max(cfg.QueueSize, 0)
+
+	h := &Hasher{
+		params: &argon2id.Params{
+			Memory:      cfg.Memory,
+			Iterations:  cfg.Iterations,
+			Parallelism: cfg.Parallelism,
+			SaltLength:  cfg.SaltLength,
+			KeyLength:   cfg.KeyLength,
+		},
+		workers: make(chan struct{}, workers),
+		slots:   make(chan struct{}, workers+queue),
+	}
+	h.metrics = newMetrics(h)
+
+	return h
+}
+
+// Hash hashes a password with argon2id using configured parameters.
+func (h *Hasher) Hash(ctx context.Context, password string) (string, error) {
+	release, err := h.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	return argon2id.CreateHash(password, h.params)
+}
+
+// Verify checks password against a stored hash. needsRehash is true when the
+// password matches but the hash is not argon2id with current parameters.
+func (h *Hasher) Verify(ctx context.Context, password, hashed string) (match, needsRehash bool, err error) {
+	verify := h.verifier(hashed)
+	if verify == nil {
+		return false, false, ErrUnknownFormat
+	}
+
+	release, err := h.acquire(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	defer release()
+
+	return verify(password, hashed)
+}
+
+type verifyFunc func(password, hashed string) (match, needsRehash bool, err error)
+
+// verifier picks a verify function by hash prefix so that unknown formats are
+// rejected without taking a worker.
+func (h *Hasher) verifier(hashed string) verifyFunc {
+	switch {
+	case strings.HasPrefix(hashed, "$argon2id$"):
+		return h.verifyArgon2id
+	case strings.HasPrefix(hashed, "$2a$"), strings.HasPrefix(hashed, "$2b$"), strings.HasPrefix(hashed, "$2y$"):
+		return verifyBcrypt
+	case strings.HasPrefix(hashed, "pbkdf2_"):
+		return rehashOnMatch(verifyDjango)
+	case strings.HasPrefix(hashed, "$pbkdf2"):
+		return rehashOnMatch(verifyPasslib)
+	default:
+		return nil
+	}
+}
+
+// acquire takes a queue slot without blocking, then waits for a worker until ctx
+// is done. The returned func gives both back.
+func (h *Hasher) acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case h.slots <- struct{}{}:
+	default:
+		h.metrics.rejected(ctx)
+		return nil, ErrBusy
+	}
+
+	start := time.Now()
+	select {
+	case h.workers <- struct{}{}:
+	case <-ctx.Done():
+		<-h.slots
+		return nil, ctx.Err()
+	}
+	h.metrics.waited(ctx, time.Since(start))
+
+	return func() {
+		<-h.workers
+		<-h.slots
+	}, nil
+}
+
+func (h *Hasher) verifyArgon2id(password, hashed string) (bool, bool, error) {
+	match, params, err := argon2id.CheckHash(password, hashed)
+	if err != nil {
+		return false, false, err
+	}
+	return match, match && *params != *h.params, nil
+}
+
+func verifyBcrypt(password, hashed string) (bool, bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+// rehashOnMatch adapts a verifier for a legacy format, which always needs a
+// rehash once it matches.
+func rehashOnMatch(verify func(password, hashed string) (bool, error)) verifyFunc {
+	return func(password, hashed string) (bool, bool, error) {
+		match, err := verify(password, hashed)
+		return match, match, err
+	}
+}
+
+// verifyDjango verifies pbkdf2_<digest>$<iterations>$<salt>$<base64 key>. Salt
+// is used as is.
+func verifyDjango(password, hashed string) (bool, error) {
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 4 {
+		return false, ErrUnknownFormat
+	}
+
+	digest, err := digestFunc(strings.TrimPrefix(parts[0], "pbkdf2_"))
+	if err != nil {
+		return false, err
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false, ErrUnknownFormat
+	}
+
+	key, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false, ErrUnknownFormat
+	}
+
+	derived := pbkdf2.Key([]byte(password), []byte(parts[2]), iterations, len(key), digest)
+
+	return subtle.ConstantTimeCompare(derived, key) == 1, nil
+}
+
+// verifyPasslib verifies $pbkdf2[-<digest>]$<iterations>$<salt>$<key> where salt
+// and key use passlib's base64 alphabet, which replaces '+' with '.'.
+func verifyPasslib(password, hashed string) (bool, error) {
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 5 {
+		return false, ErrUnknownFormat
+	}
+
+	name := strings.TrimPrefix(parts[1], "pbkdf2")
+	name = strings.TrimPrefix(name, "-")
+	if name == "" {
+		name = "sha1"
+	}
+	digest, err := digestFunc(name)
+	if err != nil {
+		return false, err
+	}
+
+	iterations, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return false, ErrUnknownFormat
+	}
+
+	salt, err := passlibDecode(parts[3])
+	if err != nil {
+		return false, ErrUnknownFormat
+	}
+	key, err := passlibDecode(parts[4])
+	if err != nil {
+		return false, ErrUnknownFormat
+	}
+
+	derived := pbkdf2.Key([]byte(password), salt, iterations, len(key), digest)
+
+	return subtle.ConstantTimeCompare(derived, key) == 1, nil
+}
+
+func passlibDecode(s string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.ReplaceAll(s, ".", "+"))
+}
+
+func digestFunc(name string) (func() hash.Hash, error) {
+	switch name {
+	case "sha1":
+		return sha1.New, nil
+	case "sha256":
+		return sha256.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
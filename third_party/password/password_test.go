@@ -0,0 +1,194 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/gmhafiz/go8/config"
+)
+
+const plain = "highEntropyPassword"
+
+var cfg = config.Password{
+	Memory:      8 * 1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+	Workers:     1,
+	QueueSize:   1,
+}
+
+func TestHasher_Verify(t *testing.T) {
+	h := New(cfg)
+
+	current, err := h.Hash(context.Background(), plain)
+	assert.Nil(t, err)
+
+	outdated, err := argon2id.CreateHash(plain, &argon2id.Params{
+		Memory:      4 * 1024,
+		Iterations:  2,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	assert.Nil(t, err)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.MinCost)
+	assert.Nil(t, err)
+
+	type want struct {
+		match       bool
+		needsRehash bool
+		err         error
+	}
+	tests := []struct {
+		name     string
+		password string
+		hashed   string
+		want     want
+	}{
+		{
+			name:     "argon2id with current parameters",
+			password: plain,
+			hashed:   current,
+			want:     want{match: true},
+		},
+		{
+			name:     "argon2id with outdated parameters",
+			password: plain,
+			hashed:   outdated,
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "argon2id wrong password",
+			password: "wrong",
+			hashed:   outdated,
+			want:     want{},
+		},
+		{
+			name:     "bcrypt",
+			password: plain,
+			hashed:   string(bcryptHash),
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "bcrypt wrong password",
+			password: "wrong",
+			hashed:   string(bcryptHash),
+			want:     want{},
+		},
+		{
+			name:     "django pbkdf2 sha256",
+			password: plain,
+			hashed:   "pbkdf2_sha256$260000$Zm9vYmFyc2FsdA$5pkLsbobWiOwW9v04mxgwFCJERR+hRdfbB3SLyyA9qs=",
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "django pbkdf2 sha1",
+			password: plain,
+			hashed:   "pbkdf2_sha1$10000$legacysalt$ws+1TRCoWZn91muhjhJdJy+pGrU=",
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "django pbkdf2 wrong password",
+			password: "wrong",
+			hashed:   "pbkdf2_sha256$260000$Zm9vYmFyc2FsdA$5pkLsbobWiOwW9v04mxgwFCJERR+hRdfbB3SLyyA9qs=",
+			want:     want{},
+		},
+		{
+			name:     "passlib pbkdf2 sha512",
+			password: plain,
+			hashed:   "$pbkdf2-sha512$25000$yMnKy8zNzs/Q0dLT1NXW1w$Ot/4fUWmeCFk3Qt4K6WCYwRJ93OsOK74C5FImkLrkx0KJQuiRictC3aK9GO/kTvQdeyYUGwZ8xHODrFMY34PDQ",
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "passlib pbkdf2 sha1",
+			password: plain,
+			hashed:   "$pbkdf2$29000$yMnKy8zNzs/Q0dLT1NXW1w$MQhi8zFEt7VbuMoqrIAi0kgKQWQ",
+			want:     want{match: true, needsRehash: true},
+		},
+		{
+			name:     "unknown format",
+			password: plain,
+			hashed:   "5f4dcc3b5aa765d61d8327deb882cf99",
+			want:     want{err: ErrUnknownFormat},
+		},
+		{
+			name:     "unknown digest",
+			password: plain,
+			hashed:   "pbkdf2_md5$1000$salt$c2FsdA==",
+			want:     want{err: ErrUnknownFormat},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, needsRehash, err := h.Verify(context.Background(), tt.password, tt.hashed)
+
+			assert.Equal(t, tt.want.err, err)
+			assert.Equal(t, tt.want.match, match)
+			assert.Equal(t, tt.want.needsRehash, needsRehash)
+		})
+	}
+}
+
+func TestHasher_Hash(t *testing.T) {
+	h := New(cfg)
+
+	hashed, err := h.Hash(context.Background(), plain)
+	assert.Nil(t, err)
+
+	params, _, _, err := argon2id.DecodeHash(hashed)
+	assert.Nil(t, err)
+	assert.Equal(t, cfg.Memory, params.Memory)
+	assert.Equal(t, cfg.Iterations, params.Iterations)
+	assert.Equal(t, cfg.Parallelism, params.Parallelism)
+}
+
+func TestHasher_Busy(t *testing.T) {
+	h := New(cfg)
+	ctx := context.Background()
+
+	release, err := h.acquire(ctx)
+	assert.Nil(t, err)
+
+	// The only worker is taken, so this call waits in the only queue slot.
+	queued := make(chan error)
+	go func() {
+		_, err := h.Hash(ctx, plain)
+		queued <- err
+	}()
+	assert.Eventually(t, func() bool { return len(h.slots) == 2 }, time.Second, time.Millisecond)
+
+	_, err = h.Hash(ctx, plain)
+	assert.ErrorIs(t, err, ErrBusy)
+
+	_, _, err = h.Verify(ctx, plain, "unknown")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+
+	release()
+	assert.Nil(t, <-queued)
+	assert.Equal(t, 0, len(h.slots))
+	assert.Equal(t, 0, len(h.workers))
+}
+
+func TestHasher_CancelWhileQueued(t *testing.T) {
+	h := New(cfg)
+
+	release, err := h.acquire(context.Background())
+	assert.Nil(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = h.Hash(ctx, plain)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, len(h.slots))
+}
@@ -2,16 +2,25 @@
 // It is nearly identical to it except:
 //
 //  1. It saves a uint64 data along with the session data for the purpose of user session invalidation.
-//  2. Tokens are hashed before being saved into the database.
+//  2. Tokens are hashed with HMAC-SHA256 before being saved into the database. See tokenhash
+//     package on how secrets are rotated.
+//  3. It records where each session was created from, and when it was last seen, so that users
+//     can list and revoke their own sessions.
 //
-// The schema is identical to scs library but with added `user_id` foreign key column:
+// The schema is identical to scs library but with added `user_id` foreign key column and device columns:
 //
 //	CREATE TABLE IF NOT EXISTS sessions
 //	(
-//	    token   TEXT PRIMARY KEY,
-//	    user_id BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
-//	    data    BYTEA       NOT NULL,
-//	    expiry  TIMESTAMPTZ NOT NULL
+//	    token        TEXT PRIMARY KEY,
+//	    user_id      BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
+//	    data         BYTEA       NOT NULL,
+//	    expiry       TIMESTAMPTZ NOT NULL,
+//	    public_id    UUID        NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+//	    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+//	    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+//	    ip_address   TEXT,
+//	    user_agent   TEXT,
+//	    device       TEXT
 //	);
 //
 // If number of records in `expiry` column is large, can consider indexing it using BRIN index
@@ -22,47 +31,46 @@ package postgresstore
 import (
 	"context"
 	"database/sql"
-	"encoding/hex"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/cespare/xxhash/v2"
-
 	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
 )
 
 // PostgresStore represents the session store.
 type PostgresStore struct {
 	db          *sql.DB
+	hasher      *tokenhash.Hasher
 	stopCleanup chan bool
 }
 
+// Delete is the same as DeleteCtx using a background context.
 func (p *PostgresStore) Delete(token string) (err error) {
-	panic("missing context arg")
+	return p.DeleteCtx(context.Background(), token)
 }
 
+// Find is the same as FindCtx using a background context.
 func (p *PostgresStore) Find(token string) (b []byte, found bool, err error) {
-	panic("missing context arg")
+	return p.FindCtx(context.Background(), token)
 }
 
+// Commit is the same as CommitCtx using a background context. No user ID is
+// saved since it is only available from request context.
 func (p *PostgresStore) Commit(token string, b []byte, expiry time.Time) (err error) {
-	panic("missing context arg")
+	return p.CommitCtx(context.Background(), token, b, expiry)
 }
 
 // FindCtx returns the data for a given session token from the PostgresStore instance.
 // If the session token is not found or is expired, the returned exists flag will
 // be set to false.
 func (p *PostgresStore) FindCtx(ctx context.Context, token string) (b []byte, exists bool, err error) {
-	hash, err := sum(token)
-	if err != nil {
-		return nil, false, err
-	}
-
 	row := p.db.QueryRowContext(ctx, `
 		SELECT data FROM sessions 
-            WHERE token = $1 
+            WHERE token = ANY(string_to_array($1, ','))
               AND current_timestamp < expiry 
-            ORDER BY expiry desc`, hash)
+            ORDER BY expiry desc`, p.sums(token))
 	err = row.Scan(&b)
 	if err == sql.ErrNoRows {
 		return nil, false, nil
@@ -74,7 +82,8 @@ func (p *PostgresStore) FindCtx(ctx context.Context, token string) (b []byte, ex
 
 // CommitCtx adds a session token and data to the PostgresStore instance with the
 // given expiry time. If the session token already exists, then the data and expiry
-// time are updated. Hashed token is stored into database. User ID is retrieved from request
+// time are updated. Hashed token is stored into database. A session hashed with an
+// older secret is re-hashed with the newest one. User ID is retrieved from request
 // context since modifying method signature will no longer implements scs's Store interface.
 func (p *PostgresStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
 	var userID any
@@ -83,34 +92,135 @@ func (p *PostgresStore) CommitCtx(ctx context.Context, token string, b []byte, e
 		userID = nil
 	}
 
-	hash, err := sum(token)
-	if err != nil {
-		return err
-	}
+	client := middleware.ClientFromContext(ctx)
 
-	_, err = p.db.ExecContext(ctx, `
-		INSERT INTO sessions (token, user_id, data, expiry) 
-		VALUES ($1, $2, $3, $4) 
+	_, err := p.db.ExecContext(ctx, `
+		WITH updated AS (
+			UPDATE sessions 
+			SET token = $1,
+				data = $3, 
+				expiry = $4,
+				last_seen_at = current_timestamp
+			WHERE token = ANY(string_to_array($2, ','))
+			RETURNING token
+		)
+		INSERT INTO sessions (token, user_id, data, expiry, ip_address, user_agent, device) 
+		SELECT $1::text, $5::bigint, $3::bytea, $4::timestamptz, $6::text, $7::text, $8::text
+		WHERE NOT EXISTS (SELECT 1 FROM updated)
 		ON CONFLICT (token) 
 			DO UPDATE 
 			SET data = EXCLUDED.data, 
-				expiry = EXCLUDED.expiry
-				`, hash, userID, b, expiry)
+				expiry = EXCLUDED.expiry,
+				last_seen_at = current_timestamp
+				`, p.hasher.Sum(token), p.sums(token), b, expiry, userID, client.IPAddress, client.UserAgent, client.Device)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// DeleteCtx removes a session token and corresponding data from the PostgresStore
-// instance.
-func (p *PostgresStore) DeleteCtx(ctx context.Context, token string) error {
-	hash, err := sum(token)
+// TouchCtx checks if a session token exists and is not expired. Its last seen
+// time and IP address are updated at most once a minute to avoid a write on
+// every request.
+func (p *PostgresStore) TouchCtx(ctx context.Context, token string) (bool, error) {
+	client := middleware.ClientFromContext(ctx)
+
+	var found bool
+	err := p.db.QueryRowContext(ctx, `
+		WITH found AS (
+			SELECT token, last_seen_at FROM sessions
+			WHERE token = ANY(string_to_array($1, ','))
+			  AND current_timestamp < expiry
+		), touched AS (
+			UPDATE sessions
+			SET last_seen_at = current_timestamp,
+				ip_address = COALESCE(NULLIF($2, ''), sessions.ip_address)
+			FROM found
+			WHERE sessions.token = found.token
+			  AND found.last_seen_at < current_timestamp - interval '1 minute'
+		)
+		SELECT EXISTS(SELECT 1 FROM found)`, p.sums(token), client.IPAddress).Scan(&found)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	return found, nil
+}
+
+// ListByUserCtx returns all active sessions of a user, most recently seen first.
+func (p *PostgresStore) ListByUserCtx(ctx context.Context, userID uint64, currentToken string) ([]middleware.SessionInfo, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT public_id, 
+		       created_at, 
+		       last_seen_at, 
+		       expiry, 
+		       COALESCE(ip_address, ''), 
+		       COALESCE(user_agent, ''), 
+		       COALESCE(device, ''), 
+		       token = ANY(string_to_array($2, ','))
+		FROM sessions
+		WHERE user_id = $1
+		  AND current_timestamp < expiry
+		ORDER BY last_seen_at DESC`, userID, p.sums(currentToken))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]middleware.SessionInfo, 0)
+	for rows.Next() {
+		var s middleware.SessionInfo
+		err = rows.Scan(&s.ID, &s.CreatedAt, &s.LastSeenAt, &s.Expiry, &s.IPAddress, &s.UserAgent, &s.Device, &s.Current)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	return sessions, rows.Err()
+}
+
+// DeleteByUserCtx deletes a session by its public ID if it belongs to the user.
+func (p *PostgresStore) DeleteByUserCtx(ctx context.Context, userID uint64, id string) (bool, error) {
+	res, err := p.db.ExecContext(ctx, `
+		DELETE FROM sessions 
+		WHERE user_id = $1 
+		  AND public_id::text = $2`, userID, id)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, err
 	}
 
-	_, err = p.db.ExecContext(ctx, "DELETE FROM sessions WHERE token = $1", hash)
+	return deleted > 0, nil
+}
+
+// DeleteAllByUserCtx deletes all sessions of a user except the one belonging to
+// exceptToken. Pass an empty exceptToken to delete every session.
+func (p *PostgresStore) DeleteAllByUserCtx(ctx context.Context, userID uint64, exceptToken string) (int64, error) {
+	var except string
+	if exceptToken != "" {
+		except = p.sums(exceptToken)
+	}
+
+	res, err := p.db.ExecContext(ctx, `
+		DELETE FROM sessions 
+		WHERE user_id = $1 
+		  AND NOT token = ANY(string_to_array($2, ','))`, userID, except)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// DeleteCtx removes a session token and corresponding data from the PostgresStore
+// instance.
+func (p *PostgresStore) DeleteCtx(ctx context.Context, token string) error {
+	_, err := p.db.ExecContext(ctx, "DELETE FROM sessions WHERE token = ANY(string_to_array($1, ','))", p.sums(token))
 	return err
 }
 
@@ -149,16 +259,16 @@ func (p *PostgresStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
 
 // New returns a new PostgresStore instance, with a background cleanup goroutine
 // that runs every 5 minutes to remove expired session data.
-func New(db *sql.DB) *PostgresStore {
-	return NewWithCleanupInterval(db, 5*time.Minute)
+func New(db *sql.DB, hasher *tokenhash.Hasher) *PostgresStore {
+	return NewWithCleanupInterval(db, hasher, 5*time.Minute)
 }
 
 // NewWithCleanupInterval returns a new PostgresStore instance. The cleanupInterval
 // parameter controls how frequently expired session data is removed by the
 // background cleanup goroutine. Setting it to 0 prevents the cleanup goroutine
 // from running (i.e. expired sessions will not be removed).
-func NewWithCleanupInterval(db *sql.DB, cleanupInterval time.Duration) *PostgresStore {
-	p := &PostgresStore{db: db}
+func NewWithCleanupInterval(db *sql.DB, hasher *tokenhash.Hasher, cleanupInterval time.Duration) *PostgresStore {
+	p := &PostgresStore{db: db, hasher: hasher}
 	if cleanupInterval > 0 {
 		go p.startCleanup(cleanupInterval)
 	}
@@ -203,15 +313,13 @@ func (p *PostgresStore) deleteExpired() error {
 	return err
 }
 
-func sum(token string) (string, error) {
-	h := xxhash.New()
-	_, err := h.Write([]byte(token))
-	if err != nil {
-
-		return "", err
-	}
-	sum := h.Sum(nil)
-	str := hex.EncodeToString(sum)
-
-	return str, err
+// sums returns hashes of token with every secret as a comma separated list,
+// to be queried with `token = ANY(string_to_array($1, ','))`.
+func (p *PostgresStore) sums(token string) string {
+	return strings.Join(p.hasher.Sums(token), ",")
 }
+
+var (
+	_ middleware.UserSessionStore = (*PostgresStore)(nil)
+	_ middleware.SessionToucher   = (*PostgresStore)(nil)
+)
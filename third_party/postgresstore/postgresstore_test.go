@@ -16,8 +16,12 @@ import (
 
 	"github.com/gmhafiz/go8/config"
 	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+	"github.com/gmhafiz/go8/third_party/sessiontest"
 )
 
+var hasher, _ = tokenhash.New("test-secret")
+
 func TestMain(m *testing.M) {
 	getwd, err := os.Getwd()
 	if err != nil {
@@ -72,10 +76,16 @@ CREATE TABLE IF NOT EXISTS users
 	_, err = db.ExecContext(ctx, `
 CREATE TABLE IF NOT EXISTS sessions
 (
-    token   TEXT PRIMARY KEY,
-    user_id BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
-    data    BYTEA       NOT NULL,
-    expiry  TIMESTAMPTZ NOT NULL
+    token        TEXT PRIMARY KEY,
+    user_id      BIGINT      CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
+    data         BYTEA       NOT NULL,
+    expiry       TIMESTAMPTZ NOT NULL,
+    public_id    UUID        NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ip_address   TEXT,
+    user_agent   TEXT,
+    device       TEXT
 );`)
 	if err != nil {
 		log.Println(err)
@@ -95,7 +105,7 @@ ON CONFLICT DO NOTHING
 	os.Exit(code)
 }
 
-func TestFind(t *testing.T) {
+func TestContract(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -109,16 +119,39 @@ func TestFind(t *testing.T) {
 	if err = db.Ping(); err != nil {
 		t.Fatal(err)
 	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
+
+	sessiontest.Run(t, func(t *testing.T) sessiontest.Factory {
+		_, err := db.Exec("TRUNCATE TABLE sessions")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return func(hasher *tokenhash.Hasher) sessiontest.Store {
+			return NewWithCleanupInterval(db, hasher, 0)
+		}
+	})
+}
+
+func TestFind(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	hash, err := sum("session_token")
+	defer db.Close()
+	if err = db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("TRUNCATE TABLE sessions")
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	hash := hasher.Sum("session_token")
+
 	_, err = db.Exec(`
 INSERT INTO sessions
 VALUES($1, $2, $3, current_timestamp + interval '1 minute')`, hash, 1, "encoded_data")
@@ -126,7 +159,7 @@ VALUES($1, $2, $3, current_timestamp + interval '1 minute')`, hash, 1, "encoded_
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 
@@ -161,7 +194,7 @@ func TestFindMissing(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 
@@ -193,7 +226,7 @@ func TestSaveNew(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
@@ -203,10 +236,7 @@ func TestSaveNew(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
+	hash := hasher.Sum("session_token")
 
 	row := db.QueryRow("SELECT data FROM sessions WHERE token = $1", hash)
 	var data []byte
@@ -242,7 +272,7 @@ func TestSaveUpdated(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
@@ -252,10 +282,7 @@ func TestSaveUpdated(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
+	hash := hasher.Sum("session_token")
 
 	row := db.QueryRow("SELECT data FROM sessions WHERE token = $1", hash)
 	var data []byte
@@ -287,7 +314,7 @@ func TestExpiry(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
@@ -328,17 +355,14 @@ func TestDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
+	hash := hasher.Sum("session_token")
 
 	_, err = db.Exec("INSERT INTO sessions VALUES($1, 1, 'encoded_data', current_timestamp + interval '1 minute')", hash)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 
 	ctx := context.Background()
 
@@ -377,16 +401,13 @@ func TestCleanup(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 200*time.Millisecond)
+	p := NewWithCleanupInterval(db, hasher, 200*time.Millisecond)
 	defer p.StopCleanup()
 
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
 
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
+	hash := hasher.Sum("session_token")
 
 	err = p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(100*time.Millisecond))
 	if err != nil {
@@ -429,8 +450,95 @@ func TestStopNilCleanup(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
+	p := NewWithCleanupInterval(db, hasher, 0)
 	time.Sleep(100 * time.Millisecond)
 	// A send to a nil channel will block forever
 	p.StopCleanup()
 }
+
+func TestUserSessions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err = db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("TRUNCATE TABLE sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewWithCleanupInterval(db, hasher, 0)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
+	ctx = context.WithValue(ctx, middleware.KeyClient, middleware.Client{
+		IPAddress: "127.0.0.1",
+		UserAgent: "curl/8.0.1",
+		Device:    "curl",
+	})
+
+	for _, token := range []string{"session_token", "other_token", "another_token"} {
+		err = p.CommitCtx(ctx, token, []byte("encoded_data"), time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	found, err := p.TouchCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	sessions, err := p.ListByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 3 {
+		t.Fatalf("got %d: expected %d", len(sessions), 3)
+	}
+
+	var current int
+	for _, s := range sessions {
+		if s.Current {
+			current++
+		}
+		if s.Device != "curl" || s.IPAddress != "127.0.0.1" {
+			t.Fatalf("got %v: expected device and ip address to be recorded", s)
+		}
+	}
+	if current != 1 {
+		t.Fatalf("got %d: expected %d", current, 1)
+	}
+
+	deleted, err := p.DeleteByUserCtx(ctx, 2, sessions[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != false {
+		t.Fatalf("got %v: expected %v", deleted, false)
+	}
+
+	n, err := p.DeleteAllByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("got %d: expected %d", n, 2)
+	}
+
+	_, found, _ = p.FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+}
@@ -0,0 +1,455 @@
+// Package redisstore is a Redis implementation of github.com/alexedwards/scs session store
+// that behaves the same as postgresstore:
+//
+//  1. It saves a uint64 user ID along with the session data for the purpose of user session invalidation.
+//  2. Tokens are hashed with HMAC-SHA256 before being saved into Redis. A session hashed with
+//     an older secret is moved to a key hashed with the newest secret when it is committed.
+//  3. It records where each session was created from, and when it was last seen, so that users
+//     can list and revoke their own sessions.
+//
+// Each session is a hash that expires together with the session:
+//
+//	scs:session:<hashed token> -> data, expiry, user_id, public_id, created_at, last_seen_at,
+//	                              ip_address, user_agent, device
+//
+// Sessions belonging to a user are tracked in a set, which is what makes logging a user out
+// everywhere possible without scanning all keys:
+//
+//	scs:user:<user id> -> {<hashed token>, ...}
+//
+// Redis removes expired sessions on its own. Members of a user's set pointing to expired
+// sessions are removed lazily whenever that set is read.
+package redisstore
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+)
+
+const (
+	sessionPrefix = "scs:session:"
+	userPrefix    = "scs:user:"
+
+	fieldData       = "data"
+	fieldExpiry     = "expiry"
+	fieldUserID     = "user_id"
+	fieldPublicID   = "public_id"
+	fieldCreatedAt  = "created_at"
+	fieldLastSeenAt = "last_seen_at"
+	fieldIPAddress  = "ip_address"
+	fieldUserAgent  = "user_agent"
+	fieldDevice     = "device"
+
+	// touchInterval limits how often last seen time is written.
+	touchInterval = time.Minute
+)
+
+// touchScript updates fields of a session only if it still exists.
+var touchScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("HSET", KEYS[1], unpack(ARGV))
+	return 1
+end
+return 0
+`)
+
+// RedisStore represents the session store.
+type RedisStore struct {
+	client redis.UniversalClient
+	hasher *tokenhash.Hasher
+}
+
+// New returns a new RedisStore instance. Both a single node client and a
+// cluster client can be used.
+func New(client redis.UniversalClient, hasher *tokenhash.Hasher) *RedisStore {
+	return &RedisStore{client: client, hasher: hasher}
+}
+
+func (r *RedisStore) Delete(token string) error {
+	return r.DeleteCtx(context.Background(), token)
+}
+
+func (r *RedisStore) Find(token string) ([]byte, bool, error) {
+	return r.FindCtx(context.Background(), token)
+}
+
+func (r *RedisStore) Commit(token string, b []byte, expiry time.Time) error {
+	return r.CommitCtx(context.Background(), token, b, expiry)
+}
+
+// FindCtx returns the data for a given session token from the RedisStore instance.
+// If the session token is not found or is expired, the returned exists flag will
+// be set to false.
+func (r *RedisStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	_, values, err := r.find(ctx, token, fieldData, fieldExpiry)
+	if err != nil {
+		return nil, false, err
+	}
+
+	data, ok := values[0].(string)
+	if !ok || expired(values[1]) {
+		return nil, false, nil
+	}
+
+	return []byte(data), true, nil
+}
+
+// CommitCtx adds a session token and data to the RedisStore instance with the
+// given expiry time. If the session token already exists, then the data and expiry
+// time are updated. User ID is retrieved from request context, same as postgresstore.
+func (r *RedisStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	hash := r.hasher.Sum(token)
+	key := sessionPrefix + hash
+	now := time.Now()
+	client := middleware.ClientFromContext(ctx)
+	userID, hasUser := ctx.Value(middleware.KeyID).(uint64)
+
+	oldHash, old, err := r.findRotated(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if oldHash != "" {
+			pipe.HSet(ctx, key, old)
+			pipe.Del(ctx, sessionPrefix+oldHash)
+			if oldUserID, err := strconv.ParseUint(old[fieldUserID], 10, 64); err == nil {
+				pipe.SRem(ctx, userKey(oldUserID), oldHash)
+				pipe.SAdd(ctx, userKey(oldUserID), hash)
+			}
+		}
+		pipe.HSet(ctx, key,
+			fieldData, b,
+			fieldExpiry, expiry.UnixMilli(),
+			fieldLastSeenAt, now.UnixMilli(),
+		)
+		// These are only recorded when a session is first created.
+		pipe.HSetNX(ctx, key, fieldPublicID, uuid.NewString())
+		pipe.HSetNX(ctx, key, fieldCreatedAt, now.UnixMilli())
+		pipe.HSetNX(ctx, key, fieldIPAddress, client.IPAddress)
+		pipe.HSetNX(ctx, key, fieldUserAgent, client.UserAgent)
+		pipe.HSetNX(ctx, key, fieldDevice, client.Device)
+		if hasUser {
+			pipe.HSetNX(ctx, key, fieldUserID, userID)
+			pipe.SAdd(ctx, userKey(userID), hash)
+		}
+		pipe.PExpireAt(ctx, key, expiry)
+		return nil
+	})
+
+	return err
+}
+
+// TouchCtx checks if a session token exists and is not expired. Its last seen
+// time and IP address are updated at most once a minute to avoid a write on
+// every request.
+func (r *RedisStore) TouchCtx(ctx context.Context, token string) (bool, error) {
+	hash, values, err := r.find(ctx, token, fieldExpiry, fieldLastSeenAt)
+	if err != nil {
+		return false, err
+	}
+	if hash == "" || expired(values[0]) {
+		return false, nil
+	}
+
+	if time.Since(parseTime(values[1])) < touchInterval {
+		return true, nil
+	}
+
+	fields := []any{fieldLastSeenAt, time.Now().UnixMilli()}
+	if ip := middleware.ClientFromContext(ctx).IPAddress; ip != "" {
+		fields = append(fields, fieldIPAddress, ip)
+	}
+
+	// A plain HSET would recreate a session deleted in the meantime, without
+	// expiry.
+	touched, err := touchScript.Run(ctx, r.client, []string{sessionPrefix + hash}, fields...).Bool()
+	if err != nil {
+		return false, err
+	}
+
+	return touched, nil
+}
+
+// ListByUserCtx returns all active sessions of a user, most recently seen first.
+func (r *RedisStore) ListByUserCtx(ctx context.Context, userID uint64, currentToken string) ([]middleware.SessionInfo, error) {
+	current := r.hasher.Sums(currentToken)
+
+	hashes, sessions, err := r.userSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]middleware.SessionInfo, 0, len(sessions))
+	for i, s := range sessions {
+		infos = append(infos, middleware.SessionInfo{
+			ID:         s[fieldPublicID],
+			CreatedAt:  parseTime(s[fieldCreatedAt]),
+			LastSeenAt: parseTime(s[fieldLastSeenAt]),
+			Expiry:     parseTime(s[fieldExpiry]),
+			IPAddress:  s[fieldIPAddress],
+			UserAgent:  s[fieldUserAgent],
+			Device:     s[fieldDevice],
+			Current:    slices.Contains(current, hashes[i]),
+		})
+	}
+
+	slices.SortFunc(infos, func(a, b middleware.SessionInfo) int {
+		return b.LastSeenAt.Compare(a.LastSeenAt)
+	})
+
+	return infos, nil
+}
+
+// DeleteByUserCtx deletes a session by its public ID if it belongs to the user.
+func (r *RedisStore) DeleteByUserCtx(ctx context.Context, userID uint64, id string) (bool, error) {
+	hashes, sessions, err := r.userSessions(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for i, s := range sessions {
+		if s[fieldPublicID] == id {
+			deleted, err := r.delete(ctx, userID, hashes[i])
+			return deleted > 0, err
+		}
+	}
+
+	return false, nil
+}
+
+// DeleteAllByUserCtx deletes all sessions of a user except the one belonging to
+// exceptToken. Pass an empty exceptToken to delete every session.
+func (r *RedisStore) DeleteAllByUserCtx(ctx context.Context, userID uint64, exceptToken string) (int64, error) {
+	var except []string
+	if exceptToken != "" {
+		except = r.hasher.Sums(exceptToken)
+	}
+
+	hashes, err := r.client.SMembers(ctx, userKey(userID)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	hashes = slices.DeleteFunc(hashes, func(hash string) bool {
+		return slices.Contains(except, hash)
+	})
+
+	return r.delete(ctx, userID, hashes...)
+}
+
+// DeleteCtx removes a session token and corresponding data from the RedisStore
+// instance.
+func (r *RedisStore) DeleteCtx(ctx context.Context, token string) error {
+	hash, values, err := r.find(ctx, token, fieldUserID)
+	if err != nil || hash == "" {
+		return err
+	}
+
+	userID, _ := strconv.ParseUint(str(values[0]), 10, 64)
+	if userID == 0 {
+		return r.client.Del(ctx, sessionPrefix+hash).Err()
+	}
+
+	_, err = r.delete(ctx, userID, hash)
+	return err
+}
+
+// AllCtx returns a map containing the hashed token and data for all active (i.e.
+// not expired) sessions in the RedisStore instance.
+func (r *RedisStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	sessions := make(map[string][]byte)
+
+	iter := r.client.Scan(ctx, 0, sessionPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+
+		values, err := r.client.HMGet(ctx, key, fieldData, fieldExpiry).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		data, ok := values[0].(string)
+		if !ok || expired(values[1]) {
+			continue
+		}
+
+		sessions[key[len(sessionPrefix):]] = []byte(data)
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// find looks up a session hashed with any of the secrets, newest first. It
+// returns the hash the session is stored under, or an empty string if it does
+// not exist, along with requested fields.
+func (r *RedisStore) find(ctx context.Context, token string, fields ...string) (string, []any, error) {
+	sums := r.hasher.Sums(token)
+	keys := append(slices.Clone(fields), fieldExpiry)
+
+	cmds := make([]*redis.SliceCmd, len(sums))
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hash := range sums {
+			cmds[i] = pipe.HMGet(ctx, sessionPrefix+hash, keys...)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", nil, err
+	}
+
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		// Expiry is always set, so it tells whether the session exists.
+		if values[len(values)-1] != nil {
+			return sums[i], values[:len(fields)], nil
+		}
+	}
+
+	return "", make([]any, len(fields)), nil
+}
+
+// findRotated returns a session stored under a hash made with an older
+// secret, so that it can be moved under the newest one.
+func (r *RedisStore) findRotated(ctx context.Context, token string) (string, map[string]string, error) {
+	sums := r.hasher.Sums(token)
+	if len(sums) == 1 {
+		return "", nil, nil
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(sums))
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hash := range sums {
+			cmds[i] = pipe.HGetAll(ctx, sessionPrefix+hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Already stored under the newest hash.
+	if len(cmds[0].Val()) > 0 {
+		return "", nil, nil
+	}
+
+	for i, cmd := range cmds[1:] {
+		if session := cmd.Val(); len(session) > 0 {
+			return sums[i+1], session, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+// userSessions returns hashed tokens and fields of each active session of a
+// user. Set members of sessions that no longer exist are removed.
+func (r *RedisStore) userSessions(ctx context.Context, userID uint64) ([]string, []map[string]string, error) {
+	hashes, err := r.client.SMembers(ctx, userKey(userID)).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(hashes))
+	_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hash := range hashes {
+			cmds[i] = pipe.HGetAll(ctx, sessionPrefix+hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		active   []string
+		sessions []map[string]string
+		stale    []any
+	)
+	for i, cmd := range cmds {
+		s := cmd.Val()
+		if len(s) == 0 || expired(s[fieldExpiry]) {
+			stale = append(stale, hashes[i])
+			continue
+		}
+		active = append(active, hashes[i])
+		sessions = append(sessions, s)
+	}
+
+	if len(stale) > 0 {
+		if err = r.client.SRem(ctx, userKey(userID), stale...).Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return active, sessions, nil
+}
+
+// delete removes sessions and their membership of the user's set. It returns
+// the number of sessions that still existed.
+func (r *RedisStore) delete(ctx context.Context, userID uint64, hashes ...string) (int64, error) {
+	if len(hashes) == 0 {
+		return 0, nil
+	}
+
+	dels := make([]*redis.IntCmd, len(hashes))
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hash := range hashes {
+			dels[i] = pipe.Del(ctx, sessionPrefix+hash)
+			pipe.SRem(ctx, userKey(userID), hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for _, del := range dels {
+		deleted += del.Val()
+	}
+
+	return deleted, nil
+}
+
+func userKey(userID uint64) string {
+	return userPrefix + strconv.FormatUint(userID, 10)
+}
+
+// expired reports whether a stored expiry, in unix milliseconds, has passed.
+// Redis expires keys on its own, but its precision is not guaranteed.
+func expired(v any) bool {
+	return !time.Now().Before(parseTime(v))
+}
+
+func parseTime(v any) time.Time {
+	ms, err := strconv.ParseInt(str(v), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// str returns a string field, which is nil if the field does not exist.
+func str(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+var (
+	_ middleware.UserSessionStore = (*RedisStore)(nil)
+	_ middleware.SessionToucher   = (*RedisStore)(nil)
+)
@@ -0,0 +1,121 @@
+package redisstore
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+	"github.com/gmhafiz/go8/third_party/sessiontest"
+)
+
+var hasher, _ = tokenhash.New("test-secret")
+
+func newClient(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, mr
+}
+
+func newStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	client, mr := newClient(t)
+	return New(client, hasher), mr
+}
+
+func TestContract(t *testing.T) {
+	sessiontest.Run(t, func(t *testing.T) sessiontest.Factory {
+		client, _ := newClient(t)
+		return func(hasher *tokenhash.Hasher) sessiontest.Store {
+			return New(client, hasher)
+		}
+	})
+}
+
+func TestCommitSetsTTL(t *testing.T) {
+	store, mr := newStore(t)
+
+	ctx := context.WithValue(context.Background(), middleware.KeyID, uint64(1))
+	err := store.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := hasher.Sum("session_token")
+
+	ttl := mr.TTL(sessionPrefix + hash)
+	if ttl <= 59*time.Minute || ttl > time.Hour {
+		t.Fatalf("got %v: expected about %v", ttl, time.Hour)
+	}
+
+	// Plain text token is never stored.
+	if mr.Exists(sessionPrefix + "session_token") {
+		t.Fatalf("expected token to be hashed")
+	}
+}
+
+func TestStaleUserSessionsAreRemoved(t *testing.T) {
+	store, mr := newStore(t)
+
+	ctx := context.WithValue(context.Background(), middleware.KeyID, uint64(1))
+	err := store.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr.FastForward(2 * time.Minute)
+
+	sessions, err := store.ListByUserCtx(ctx, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("got %d: expected %d", len(sessions), 0)
+	}
+
+	members, err := mr.Members(userKey(1))
+	if err == nil && len(members) != 0 {
+		t.Fatalf("got %v: expected user set to be emptied", members)
+	}
+}
+
+func TestTouchUpdatesLastSeen(t *testing.T) {
+	store, mr := newStore(t)
+
+	ctx := context.WithValue(context.Background(), middleware.KeyID, uint64(1))
+	err := store.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := hasher.Sum("session_token")
+	key := sessionPrefix + hash
+
+	lastSeen := time.Now().Add(-2 * time.Minute).UnixMilli()
+	mr.HSet(key, fieldLastSeenAt, strconv.FormatInt(lastSeen, 10))
+
+	ctx = context.WithValue(ctx, middleware.KeyClient, middleware.Client{IPAddress: "10.0.0.1"})
+	found, err := store.TouchCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	if got := mr.HGet(key, fieldLastSeenAt); got == strconv.FormatInt(lastSeen, 10) {
+		t.Fatalf("expected last seen time to be updated")
+	}
+	if got := mr.HGet(key, fieldIPAddress); got != "10.0.0.1" {
+		t.Fatalf("got %v: expected %v", got, "10.0.0.1")
+	}
+	if ttl := mr.TTL(key); ttl <= 0 {
+		t.Fatalf("got %v: expected expiry to be kept", ttl)
+	}
+}
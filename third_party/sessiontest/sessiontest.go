@@ -0,0 +1,460 @@
+// Package sessiontest is a contract test suite for session stores. Every store
+// used with middleware.Authenticate is expected to pass it:
+//
+//	func TestContract(t *testing.T) {
+//		sessiontest.Run(t, func(t *testing.T) sessiontest.Factory {
+//			backend := ... // empty
+//			return func(hasher *tokenhash.Hasher) sessiontest.Store {
+//				return New(backend, hasher)
+//			}
+//		})
+//	}
+package sessiontest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/gmhafiz/go8/internal/middleware"
+	"github.com/gmhafiz/go8/internal/utility/tokenhash"
+)
+
+// Store is everything a session store implements to support logging in,
+// and listing and revoking sessions per user.
+type Store interface {
+	scs.CtxStore
+	middleware.UserSessionStore
+	middleware.SessionToucher
+}
+
+// Factory returns stores sharing the same storage, each hashing tokens with
+// the given hasher.
+type Factory func(hasher *tokenhash.Hasher) Store
+
+// Run runs the contract against stores made by setup. Each sub-test calls
+// setup once and expects its storage to be empty.
+func Run(t *testing.T, setup func(t *testing.T) Factory) {
+	hasher, err := tokenhash.New("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		test func(t *testing.T, p Store)
+	}{
+		{name: "Find", test: testFind},
+		{name: "FindMissing", test: testFindMissing},
+		{name: "SaveUpdated", test: testSaveUpdated},
+		{name: "Expiry", test: testExpiry},
+		{name: "Delete", test: testDelete},
+		{name: "Touch", test: testTouch},
+		{name: "UserSessions", test: testUserSessions},
+		{name: "RevokeByID", test: testRevokeByID},
+		{name: "RevokeAll", test: testRevokeAll},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, setup(t)(hasher))
+		})
+	}
+
+	t.Run("SecretRotation", func(t *testing.T) {
+		testSecretRotation(t, setup(t))
+	})
+}
+
+func userContext(userID uint64) context.Context {
+	ctx := context.WithValue(context.Background(), middleware.KeyID, userID)
+	return context.WithValue(ctx, middleware.KeyClient, middleware.Client{
+		IPAddress: "127.0.0.1",
+		UserAgent: "curl/8.0.1",
+		Device:    "curl",
+	})
+}
+
+func testFind(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, found, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+	if bytes.Equal(b, []byte("encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("encoded_data"))
+	}
+}
+
+func testFindMissing(t *testing.T, p Store) {
+	_, found, err := p.FindCtx(context.Background(), "missing_session_token")
+	if err != nil {
+		t.Fatalf("got %v: expected %v", err, nil)
+	}
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
+
+func testSaveUpdated(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.CommitCtx(ctx, "session_token", []byte("new_encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, _, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(b, []byte("new_encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("new_encoded_data"))
+	}
+
+	sessions, err := p.ListByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("got %d: expected %d", len(sessions), 1)
+	}
+}
+
+func testExpiry(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, _ := p.FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	_, found, _ = p.FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	found, _ = p.TouchCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	sessions, err := p.ListByUserCtx(ctx, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("got %d: expected %d", len(sessions), 0)
+	}
+}
+
+func testDelete(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.DeleteCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	// Deleting a missing session is a no-op
+	err = p.DeleteCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatalf("got %v: expected %v", err, nil)
+	}
+}
+
+func testTouch(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	found, err := p.TouchCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	err = p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err = p.TouchCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	// Touching does not change session data
+	b, _, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(b, []byte("encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("encoded_data"))
+	}
+}
+
+func testUserSessions(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	for _, token := range []string{"session_token", "other_token", "another_token"} {
+		err := p.CommitCtx(ctx, token, []byte("encoded_data"), time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Sessions without a user, such as csrf tokens, are not listed.
+	err := p.CommitCtx(context.Background(), "anonymous_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := p.ListByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 3 {
+		t.Fatalf("got %d: expected %d", len(sessions), 3)
+	}
+
+	var current int
+	for _, s := range sessions {
+		if s.Current {
+			current++
+		}
+		if s.ID == "" {
+			t.Fatalf("got %v: expected session to have an ID", s)
+		}
+		if s.Device != "curl" || s.IPAddress != "127.0.0.1" || s.UserAgent != "curl/8.0.1" {
+			t.Fatalf("got %v: expected device and ip address to be recorded", s)
+		}
+		if s.CreatedAt.IsZero() || s.LastSeenAt.IsZero() || !s.Expiry.After(time.Now()) {
+			t.Fatalf("got %v: expected timestamps to be recorded", s)
+		}
+	}
+	if current != 1 {
+		t.Fatalf("got %d: expected %d", current, 1)
+	}
+
+	sessions, err = p.ListByUserCtx(ctx, 2, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("got %d: expected %d", len(sessions), 0)
+	}
+}
+
+func testRevokeByID(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := p.ListByUserCtx(ctx, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("got %d: expected %d", len(sessions), 1)
+	}
+
+	// Another user cannot revoke it
+	deleted, err := p.DeleteByUserCtx(ctx, 2, sessions[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != false {
+		t.Fatalf("got %v: expected %v", deleted, false)
+	}
+
+	deleted, err = p.DeleteByUserCtx(ctx, 1, sessions[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != true {
+		t.Fatalf("got %v: expected %v", deleted, true)
+	}
+
+	_, found, _ := p.FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
+
+func testRevokeAll(t *testing.T, p Store) {
+	ctx := userContext(1)
+
+	for _, token := range []string{"session_token", "other_token", "another_token"} {
+		err := p.CommitCtx(ctx, token, []byte("encoded_data"), time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := p.DeleteAllByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("got %d: expected %d", n, 2)
+	}
+
+	_, found, _ := p.FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+	_, found, _ = p.FindCtx(ctx, "other_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	n, err = p.DeleteAllByUserCtx(ctx, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("got %d: expected %d", n, 1)
+	}
+
+	_, found, _ = p.FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
+
+func testSecretRotation(t *testing.T, newStore Factory) {
+	ctx := userContext(1)
+
+	oldHasher, err := tokenhash.New("old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotatedHasher, err := tokenhash.New("new", "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newHasher, err := tokenhash.New("new")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = newStore(oldHasher).CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unknown secret cannot find the session.
+	_, found, _ := newStore(newHasher).FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	// Old secret is still accepted for lookup.
+	rotated := newStore(rotatedHasher)
+	_, found, _ = rotated.FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+	found, _ = rotated.TouchCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	sessions, err := rotated.ListByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].Current != true {
+		t.Fatalf("got %v: expected a single current session", sessions)
+	}
+	id := sessions[0].ID
+
+	// Writing re-hashes the session with the newest secret, keeping the same
+	// session.
+	err = rotated.CommitCtx(ctx, "session_token", []byte("new_encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, found, _ := newStore(newHasher).FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+	if bytes.Equal(b, []byte("new_encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("new_encoded_data"))
+	}
+	_, found, _ = newStore(oldHasher).FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+
+	sessions, err = rotated.ListByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != id {
+		t.Fatalf("got %v: expected session %s to be kept", sessions, id)
+	}
+
+	// Logging out everywhere else keeps the current session whichever secret
+	// it was hashed with.
+	n, err := rotated.DeleteAllByUserCtx(ctx, 1, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d: expected %d", n, 0)
+	}
+
+	err = rotated.DeleteCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, _ = rotated.FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
@@ -1,7 +1,188 @@
 package validate
 
-import "github.com/go-playground/validator/v10"
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
 
-func New() *validator.Validate {
-	return validator.New()
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/nl"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	nlTranslations "github.com/go-playground/validator/v10/translations/nl"
+	"golang.org/x/text/language"
+)
+
+// languages are those messages are translated to. The first is used when the
+// client accepts none of them.
+var languages = []language.Tag{language.English, language.Dutch}
+
+var (
+	matcher = language.NewMatcher(languages)
+	uni     = ut.New(en.New(), en.New(), nl.New())
+
+	translators = []*translator{
+		{Translator: mustTranslator("en")},
+		{Translator: mustTranslator("nl")},
+	}
+	registerDefaults = []func(*validator.Validate, ut.Translator) error{
+		enTranslations.RegisterDefaultTranslations,
+		nlTranslations.RegisterDefaultTranslations,
+	}
+
+	// mu keeps validators being made at the same time from adding the same
+	// translations at once.
+	mu sync.Mutex
+)
+
+// messages of the custom rules, in the order of languages.
+var messages = map[string][]string{
+	"iso8601":      {"{0} must be an ISO 8601 date", "{0} moet een ISO 8601-datum zijn"},
+	"unique_email": {"{0} is already taken", "{0} is al in gebruik"},
+}
+
+type Option func(*options)
+
+type options struct {
+	emailTaken func(ctx context.Context, email string) (bool, error)
+}
+
+// WithEmailTaken sets how the unique_email rule looks up whether an email is
+// already taken. Without it, every email is unique.
+func WithEmailTaken(emailTaken func(ctx context.Context, email string) (bool, error)) Option {
+	return func(o *options) {
+		o.emailTaken = emailTaken
+	}
+}
+
+// New returns a validator that names fields by their json tag and has its
+// messages translated for each of languages. On top of the built-in rules, it
+// has:
+//
+//	iso8601       a date such as 2006-01-02, or a date and time in RFC 3339
+//	unique_email  an email that is not taken yet, see WithEmailTaken
+func New(opts ...Option) *validator.Validate {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	v := validator.New()
+
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	_ = v.RegisterValidation("iso8601", isISO8601)
+	_ = v.RegisterValidationCtx("unique_email", func(ctx context.Context, fl validator.FieldLevel) bool {
+		if o.emailTaken == nil {
+			return true
+		}
+		taken, err := o.emailTaken(ctx, fl.Field().String())
+		if err != nil {
+			// The unique index still turns a taken email away when saving.
+			slog.ErrorContext(ctx, "looking up email", "error", err)
+			return true
+		}
+		return !taken
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i, trans := range translators {
+		if err := registerDefaults[i](v, trans); err != nil {
+			panic(err)
+		}
+		for tag, message := range messages {
+			err := v.RegisterTranslation(tag, trans, func(trans ut.Translator) error {
+				return trans.Add(tag, message[i], false)
+			}, translate)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return v
+}
+
+// Translator returns the translator of the language best matching an
+// Accept-Language header.
+func Translator(acceptLanguage string) ut.Translator {
+	_, i, _ := matcher.Match(parseAcceptLanguage(acceptLanguage)...)
+	return translators[i]
+}
+
+func parseAcceptLanguage(acceptLanguage string) []language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+	return tags
+}
+
+func isISO8601(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if _, err := time.Parse(time.DateOnly, s); err == nil {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339, s)
+	return err == nil
+}
+
+func translate(trans ut.Translator, fe validator.FieldError) string {
+	message, err := trans.T(fe.Tag(), fe.Field())
+	if err != nil {
+		return fe.Error()
+	}
+	return message
+}
+
+func mustTranslator(locale string) ut.Translator {
+	trans, ok := uni.GetTranslator(locale)
+	if !ok {
+		panic("no translator for " + locale)
+	}
+	return trans
+}
+
+// translator lets every validator register its translations on the same
+// translators. Those already added by an earlier validator are kept as they
+// are.
+type translator struct {
+	ut.Translator
+}
+
+func (t *translator) Add(key any, text string, override bool) error {
+	return existing(t.Translator.Add(key, text, override))
+}
+
+func (t *translator) AddCardinal(key any, text string, rule locales.PluralRule, override bool) error {
+	return existing(t.Translator.AddCardinal(key, text, rule, override))
+}
+
+func (t *translator) AddOrdinal(key any, text string, rule locales.PluralRule, override bool) error {
+	return existing(t.Translator.AddOrdinal(key, text, rule, override))
+}
+
+func (t *translator) AddRange(key any, text string, rule locales.PluralRule, override bool) error {
+	return existing(t.Translator.AddRange(key, text, rule, override))
+}
+
+func existing(err error) error {
+	var conflict *ut.ErrConflictingTranslation
+	if errors.As(err, &conflict) {
+		return nil
+	}
+	return err
 }
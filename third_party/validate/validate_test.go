@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslator(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{acceptLanguage: "", want: "en"},
+		{acceptLanguage: "nl", want: "nl"},
+		{acceptLanguage: "nl-BE,nl;q=0.9", want: "nl"},
+		{acceptLanguage: "fr-FR,nl;q=0.5,en;q=0.8", want: "en"},
+		{acceptLanguage: "de", want: "en"},
+		{acceptLanguage: "not a language;;", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, tt.want, Translator(tt.acceptLanguage).Locale())
+		})
+	}
+}
+
+func TestNew_ISO8601(t *testing.T) {
+	v := New()
+
+	for date, valid := range map[string]bool{
+		"2022-03-07":                    true,
+		"2022-03-07T15:04:05Z":          true,
+		"2022-03-07T15:04:05.123+08:00": true,
+		"07/03/2022":                    false,
+		"2022-13-07":                    false,
+		"2022-03-07 15:04:05":           false,
+		"":                              false,
+	} {
+		err := v.Var(date, "iso8601")
+		assert.Equal(t, valid, err == nil, date)
+	}
+}
+
+func TestNew_UniqueEmail(t *testing.T) {
+	type request struct {
+		Email string `json:"email" validate:"unique_email"`
+	}
+
+	t.Run("without a lookup", func(t *testing.T) {
+		assert.Nil(t, New().Struct(request{Email: "taken@example.com"}))
+	})
+
+	t.Run("taken", func(t *testing.T) {
+		v := New(WithEmailTaken(func(_ context.Context, email string) (bool, error) {
+			return email == "taken@example.com", nil
+		}))
+
+		assert.Nil(t, v.Struct(request{Email: "free@example.com"}))
+
+		err := v.StructCtx(context.Background(), request{Email: "taken@example.com"})
+		var errs validator.ValidationErrors
+		if assert.True(t, errors.As(err, &errs)) {
+			assert.Equal(t, "email", errs[0].Field())
+			assert.Equal(t, "email is already taken", errs[0].Translate(Translator("en")))
+			assert.Equal(t, "email is al in gebruik", errs[0].Translate(Translator("nl")))
+		}
+	})
+
+	t.Run("lookup failing", func(t *testing.T) {
+		v := New(WithEmailTaken(func(context.Context, string) (bool, error) {
+			return false, errors.New("connection refused")
+		}))
+
+		assert.Nil(t, v.Struct(request{Email: "taken@example.com"}))
+	})
+}